@@ -0,0 +1,297 @@
+package v1
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/server/service/backup"
+	"github.com/usememos/memos/store"
+)
+
+type Backup struct {
+	Name      string `json:"name"`
+	Size      int64  `json:"size"`
+	CreatedTs int64  `json:"createdTs"`
+}
+
+func (s *APIV1Service) registerBackupRoutes(g *echo.Group) {
+	g.GET("/backup", s.GetBackupList)
+	g.POST("/backup", s.CreateBackup)
+	g.POST("/backup/restore", s.RestoreBackup)
+	g.GET("/backup/:name", s.DownloadBackup)
+	g.DELETE("/backup/:name", s.DeleteBackup)
+}
+
+// GetBackupList godoc
+//
+//	@Summary	Get a list of database backups
+//	@Tags		backup
+//	@Produce	json
+//	@Success	200	{object}	[]Backup	"List of backups, latest first"
+//	@Failure	401	{object}	nil			"Missing user in session | Unauthorized"
+//	@Failure	404	{object}	nil			"Backup is not supported by the database driver"
+//	@Failure	500	{object}	nil			"Failed to find user | Failed to list backups"
+//	@Router		/api/v1/backup [GET]
+func (s *APIV1Service) GetBackupList(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user == nil || user.Role != store.RoleHost {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	if s.backupRunner == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Backup is not supported by the database driver")
+	}
+
+	list, err := s.backupRunner.ListBackups()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list backups").SetInternal(err)
+	}
+	backupList := []*Backup{}
+	for _, backup := range list {
+		backupList = append(backupList, convertBackupFromService(backup))
+	}
+	return c.JSON(http.StatusOK, backupList)
+}
+
+// CreateBackup godoc
+//
+//	@Summary	Create a database backup now
+//	@Tags		backup
+//	@Produce	json
+//	@Success	200	{object}	Backup	"Created backup"
+//	@Failure	401	{object}	nil		"Missing user in session | Unauthorized"
+//	@Failure	404	{object}	nil		"Backup is not supported by the database driver"
+//	@Failure	500	{object}	nil		"Failed to find user | Failed to create backup"
+//	@Router		/api/v1/backup [POST]
+func (s *APIV1Service) CreateBackup(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user == nil || user.Role != store.RoleHost {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	if s.backupRunner == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Backup is not supported by the database driver")
+	}
+
+	backup, err := s.backupRunner.CreateBackup(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create backup").SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, convertBackupFromService(backup))
+}
+
+// DownloadBackup godoc
+//
+//	@Summary	Download a database backup
+//	@Tags		backup
+//	@Produce	octet-stream
+//	@Param		name	path		string	true	"Backup name"
+//	@Success	200		{file}		file	"Backup file"
+//	@Failure	400		{object}	nil		"Invalid backup name"
+//	@Failure	401		{object}	nil		"Missing user in session | Unauthorized"
+//	@Failure	404		{object}	nil		"Backup is not supported by the database driver | Backup not found"
+//	@Failure	500		{object}	nil		"Failed to find user | Failed to find backup"
+//	@Router		/api/v1/backup/{name} [GET]
+func (s *APIV1Service) DownloadBackup(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user == nil || user.Role != store.RoleHost {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	if s.backupRunner == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Backup is not supported by the database driver")
+	}
+
+	name := c.Param("name")
+	filename, err := s.backupRunner.GetBackupFilePath(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid backup name").SetInternal(err)
+	}
+	if _, err := os.Stat(filename); err != nil {
+		if os.IsNotExist(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Backup not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find backup").SetInternal(err)
+	}
+	return c.Attachment(filename, name)
+}
+
+// DeleteBackup godoc
+//
+//	@Summary	Delete a database backup
+//	@Tags		backup
+//	@Produce	json
+//	@Param		name	path		string	true	"Backup name"
+//	@Success	200		{boolean}	true	"Backup deleted"
+//	@Failure	400		{object}	nil		"Invalid backup name"
+//	@Failure	401		{object}	nil		"Missing user in session | Unauthorized"
+//	@Failure	404		{object}	nil		"Backup is not supported by the database driver | Backup not found"
+//	@Failure	500		{object}	nil		"Failed to find user | Failed to delete backup"
+//	@Router		/api/v1/backup/{name} [DELETE]
+func (s *APIV1Service) DeleteBackup(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user == nil || user.Role != store.RoleHost {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	if s.backupRunner == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Backup is not supported by the database driver")
+	}
+
+	if _, err := s.backupRunner.GetBackupFilePath(c.Param("name")); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid backup name").SetInternal(err)
+	}
+	if err := s.backupRunner.DeleteBackup(c.Param("name")); err != nil {
+		if os.IsNotExist(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Backup not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete backup").SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, true)
+}
+
+// RestoreBackup godoc
+//
+//	@Summary		Restore the database from a backup
+//	@Description	Restore from an uploaded backup file, or from an existing backup selected by name.
+//	@Description	The backup is migrated if it is from an older version, and the current database is backed up first.
+//	@Tags			backup
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file	formData	file		false	"Backup file to upload"
+//	@Param			name	formData	string		false	"Name of an existing backup"
+//	@Success		200		{boolean}	true		"Database restored"
+//	@Failure		400		{object}	nil			"Invalid backup name | Backup file or name is required | Invalid backup"
+//	@Failure		401		{object}	nil			"Missing user in session | Unauthorized"
+//	@Failure		404		{object}	nil			"Backup is not supported by the database driver | Backup not found"
+//	@Failure		500		{object}	nil			"Failed to find user | Failed to save upload backup | Failed to restore backup"
+//	@Router			/api/v1/backup/restore [POST]
+func (s *APIV1Service) RestoreBackup(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user == nil || user.Role != store.RoleHost {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	if s.backupRunner == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Backup is not supported by the database driver")
+	}
+
+	var filename string
+	if name := c.FormValue("name"); name != "" {
+		filename, err = s.backupRunner.GetBackupFilePath(name)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid backup name").SetInternal(err)
+		}
+		if _, err := os.Stat(filename); err != nil {
+			if os.IsNotExist(err) {
+				return echo.NewHTTPError(http.StatusNotFound, "Backup not found")
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find backup").SetInternal(err)
+		}
+	} else {
+		file, err := c.FormFile("file")
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Backup file or name is required").SetInternal(err)
+		}
+		filename, err = saveUploadFile(file, "memos-restore-*.db")
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to save upload backup").SetInternal(err)
+		}
+		defer os.Remove(filename)
+	}
+
+	if err := s.backupRunner.RestoreBackup(ctx, filename); err != nil {
+		if errors.Is(err, backup.ErrInvalidBackup) {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid backup").SetInternal(err)
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to restore backup").SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, true)
+}
+
+// saveUploadFile saves the upload file to a temporary file named by pattern, which should be removed by the caller.
+func saveUploadFile(file *multipart.FileHeader, pattern string) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		os.Remove(dst.Name())
+		return "", err
+	}
+	if err := dst.Close(); err != nil {
+		os.Remove(dst.Name())
+		return "", err
+	}
+	return dst.Name(), nil
+}
+
+func convertBackupFromService(backup *backup.Backup) *Backup {
+	return &Backup{
+		Name:      backup.Name,
+		Size:      backup.Size,
+		CreatedTs: backup.CreatedTs,
+	}
+}
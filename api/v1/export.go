@@ -0,0 +1,869 @@
+package v1
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/usememos/memos/internal/log"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// exportArchiveVersion is the version of the archive layout, which is bumped on incompatible changes.
+	exportArchiveVersion = 1
+
+	exportManifestName = "manifest.json"
+	exportMemoDir      = "memos/"
+	exportResourceDir  = "resources/"
+	frontMatterFence   = "---\n"
+
+	// maxManifestSize and maxMemoFileSize limit the files read from an archive into memory.
+	// The resources are limited by the max upload size instead.
+	maxManifestSize = 32 << 20
+	maxMemoFileSize = 8 << 20
+)
+
+var (
+	// resourceLinkMatcher matches the links to the resources in the memo content, e.g. "/o/r/12/image.png".
+	resourceLinkMatcher = regexp.MustCompile(`/o/r/(\d+)`)
+	// memoLinkMatcher matches the wiki links to the memos by id in the memo content, e.g. "[[memo:12]]".
+	memoLinkMatcher = regexp.MustCompile(`\[\[\s*memo:(\d+)\s*\]\]`)
+)
+
+// ExportManifest is the manifest.json of an export archive.
+// The memos are not listed, as every Markdown file in memos/ is a memo.
+type ExportManifest struct {
+	Version    int               `json:"version"`
+	ExportedTs int64             `json:"exportedTs"`
+	Resources  []*ExportResource `json:"resources"`
+	Tags       []*ExportTag      `json:"tags"`
+	// RelationTypes are the custom memo relation types, which the relations in the memo files may use.
+	RelationTypes []*ExportRelationType `json:"relationTypes,omitempty"`
+	// Settings are the user settings in protojson.
+	Settings []json.RawMessage `json:"settings"`
+}
+
+type ExportResource struct {
+	ID           int32  `json:"id"`
+	CreatedTs    int64  `json:"createdTs"`
+	UpdatedTs    int64  `json:"updatedTs"`
+	Filename     string `json:"filename"`
+	Type         string `json:"type"`
+	Size         int64  `json:"size"`
+	ExternalLink string `json:"externalLink,omitempty"`
+	MemoID       *int32 `json:"memoId,omitempty"`
+	// Path is the file of the resource in the archive, which is empty for the external links.
+	Path string `json:"path,omitempty"`
+}
+
+// ExportTag is a tag with its metadata.
+type ExportTag struct {
+	Name        string   `json:"name"`
+	Color       string   `json:"color,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+	Description string   `json:"description,omitempty"`
+	SortWeight  int32    `json:"sortWeight,omitempty"`
+	Favorite    bool     `json:"favorite,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+
+	// nameOnly is set for the tags of the older archives, which have no metadata.
+	nameOnly bool
+}
+
+// UnmarshalJSON reads the tags of the older archives too, which are only their names.
+func (t *ExportTag) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*t = ExportTag{Name: name, nameOnly: true}
+		return nil
+	}
+	type exportTag ExportTag
+	return json.Unmarshal(data, (*exportTag)(t))
+}
+
+type ExportRelationType struct {
+	Name        string `json:"name"`
+	InverseName string `json:"inverseName,omitempty"`
+	Directed    bool   `json:"directed"`
+}
+
+// MemoFrontMatter is the YAML front matter of a memo file in an export archive.
+type MemoFrontMatter struct {
+	ID         int32                `yaml:"id"`
+	CreatedTs  int64                `yaml:"createdTs"`
+	UpdatedTs  int64                `yaml:"updatedTs"`
+	Visibility store.Visibility     `yaml:"visibility"`
+	RowStatus  store.RowStatus      `yaml:"rowStatus"`
+	Pinned     bool                 `yaml:"pinned"`
+	Relations  []*MemoFrontRelation `yaml:"relations,omitempty"`
+}
+
+type MemoFrontRelation struct {
+	RelatedMemoID int32                  `yaml:"relatedMemoId"`
+	Type          store.MemoRelationType `yaml:"type"`
+}
+
+type ImportResult struct {
+	MemoCount     int `json:"memoCount"`
+	ResourceCount int `json:"resourceCount"`
+	RelationCount int `json:"relationCount"`
+	TagCount      int `json:"tagCount"`
+	SettingCount  int `json:"settingCount"`
+}
+
+func (s *APIV1Service) registerExportRoutes(g *echo.Group) {
+	g.GET("/export", s.ExportUserData)
+	g.POST("/import", s.ImportUserData)
+}
+
+// ExportUserData godoc
+//
+//	@Summary	Export the memos, resources, tags, relations and settings of the current user as a zip archive
+//	@Tags		export
+//	@Produce	application/zip
+//	@Success	200	{file}		file	"Export archive"
+//	@Failure	401	{object}	nil		"Missing user in session"
+//	@Failure	500	{object}	nil		"Failed to export user data"
+//	@Router		/api/v1/export [GET]
+func (s *APIV1Service) ExportUserData(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	// Collect the rows before writing the response, so a failure can still be reported with an error status.
+	memoList, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to export user data").SetInternal(err)
+	}
+	memoFrontMatters := map[int32]*MemoFrontMatter{}
+	for _, memo := range memoList {
+		memoFrontMatters[memo.ID] = &MemoFrontMatter{
+			ID:         memo.ID,
+			CreatedTs:  memo.CreatedTs,
+			UpdatedTs:  memo.UpdatedTs,
+			Visibility: memo.Visibility,
+			RowStatus:  memo.RowStatus,
+			Pinned:     memo.Pinned,
+		}
+	}
+	memoRelationList, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to export user data").SetInternal(err)
+	}
+	for _, memoRelation := range memoRelationList {
+		if frontMatter, ok := memoFrontMatters[memoRelation.MemoID]; ok {
+			frontMatter.Relations = append(frontMatter.Relations, &MemoFrontRelation{
+				RelatedMemoID: memoRelation.RelatedMemoID,
+				Type:          memoRelation.Type,
+			})
+		}
+	}
+	manifest, err := s.buildExportManifest(ctx, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to export user data").SetInternal(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "application/zip")
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="memos-export-%s.zip"`, time.Now().Format("20060102150405")))
+	c.Response().WriteHeader(http.StatusOK)
+
+	// The headers are sent, so the errors from here can only abort the stream.
+	zipWriter := zip.NewWriter(c.Response())
+	for _, memo := range memoList {
+		if err := writeExportMemo(zipWriter, memoFrontMatters[memo.ID], memo.Content); err != nil {
+			return err
+		}
+	}
+	for _, resource := range manifest.Resources {
+		if resource.Path == "" {
+			continue
+		}
+		if err := s.writeExportResource(ctx, zipWriter, resource); err != nil {
+			return err
+		}
+	}
+	manifestWriter, err := zipWriter.Create(exportManifestName)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(manifestWriter)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return err
+	}
+	return zipWriter.Close()
+}
+
+// ImportUserData godoc
+//
+//	@Summary	Import an export archive for the current user
+//	@Description	Everything in the archive is created as new rows of the current user. The IDs of memos and resources are remapped, including the relations and the resource links in the memo content.
+//	@Tags		export
+//	@Accept		multipart/form-data
+//	@Produce	json
+//	@Param		file	formData	file			true	"Export archive"
+//	@Success	200		{object}	ImportResult	"Counts of the imported rows"
+//	@Failure	400		{object}	nil				"Upload file not found | Invalid export archive"
+//	@Failure	401		{object}	nil				"Missing user in session"
+//	@Failure	500		{object}	nil				"Failed to read upload file | Failed to import user data"
+//	@Router		/api/v1/import [POST]
+func (s *APIV1Service) ImportUserData(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil || file == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Upload file not found").SetInternal(err)
+	}
+	filename, err := saveUploadFile(file, "memos-import-*.zip")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to read upload file").SetInternal(err)
+	}
+	defer os.Remove(filename)
+
+	zipReader, err := zip.OpenReader(filename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid export archive").SetInternal(err)
+	}
+	defer zipReader.Close()
+
+	// Parse the whole archive first, so an invalid archive imports nothing.
+	manifest, err := readExportManifest(&zipReader.Reader, int64(s.getMaxUploadSizeBytes(ctx)))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid export archive").SetInternal(err)
+	}
+	importMemos, err := readExportMemos(&zipReader.Reader, manifest)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid export archive").SetInternal(err)
+	}
+
+	result, err := s.importUserData(ctx, userID, &zipReader.Reader, manifest, importMemos)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to import user data").SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func (s *APIV1Service) buildExportManifest(ctx context.Context, userID int32) (*ExportManifest, error) {
+	manifest := &ExportManifest{
+		Version:    exportArchiveVersion,
+		ExportedTs: time.Now().Unix(),
+		Resources:  []*ExportResource{},
+		Tags:       []*ExportTag{},
+		Settings:   []json.RawMessage{},
+	}
+
+	resourceList, err := s.Store.ListResources(ctx, &store.FindResource{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list resources")
+	}
+	for _, resource := range resourceList {
+		exportResource := &ExportResource{
+			ID:           resource.ID,
+			CreatedTs:    resource.CreatedTs,
+			UpdatedTs:    resource.UpdatedTs,
+			Filename:     resource.Filename,
+			Type:         resource.Type,
+			Size:         resource.Size,
+			ExternalLink: resource.ExternalLink,
+			MemoID:       resource.MemoID,
+		}
+		// The resources in the external storages are kept as links, as their blobs are not ours to copy.
+		if resource.ExternalLink == "" {
+			exportResource.Path = fmt.Sprintf("%s%d_%s", exportResourceDir, resource.ID, path.Base(resource.Filename))
+		}
+		manifest.Resources = append(manifest.Resources, exportResource)
+	}
+
+	tagList, err := s.Store.ListTags(ctx, &store.FindTag{
+		CreatorID: userID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tags")
+	}
+	for _, tag := range tagList {
+		manifest.Tags = append(manifest.Tags, &ExportTag{
+			Name:        tag.Name,
+			Color:       tag.Payload.GetColor(),
+			Icon:        tag.Payload.GetIcon(),
+			Description: tag.Payload.GetDescription(),
+			SortWeight:  tag.Payload.GetSortWeight(),
+			Favorite:    tag.Payload.GetFavorite(),
+			Aliases:     tag.Aliases,
+		})
+	}
+
+	relationTypeList, err := s.Store.ListMemoRelationTypeDefinitions(ctx, &store.FindMemoRelationTypeDefinition{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relation types")
+	}
+	for _, relationType := range relationTypeList {
+		manifest.RelationTypes = append(manifest.RelationTypes, &ExportRelationType{
+			Name:        relationType.Name,
+			InverseName: relationType.InverseName,
+			Directed:    relationType.Directed,
+		})
+	}
+
+	userSettingList, err := s.Store.ListUserSettingsV1(ctx, &store.FindUserSetting{
+		UserID: &userID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user settings")
+	}
+	for _, userSetting := range userSettingList {
+		if !isPortableUserSetting(userSetting.Key) {
+			continue
+		}
+		value, err := protojson.Marshal(userSetting)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal user setting")
+		}
+		manifest.Settings = append(manifest.Settings, value)
+	}
+	return manifest, nil
+}
+
+func (s *APIV1Service) writeExportResource(ctx context.Context, zipWriter *zip.Writer, exportResource *ExportResource) error {
+	resource, err := s.Store.GetResource(ctx, &store.FindResource{
+		ID:      &exportResource.ID,
+		GetBlob: true,
+	})
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		return errors.Errorf("resource %d not found", exportResource.ID)
+	}
+
+	writer, err := zipWriter.CreateHeader(&zip.FileHeader{
+		Name:     exportResource.Path,
+		Method:   zip.Deflate,
+		Modified: time.Unix(resource.UpdatedTs, 0),
+	})
+	if err != nil {
+		return err
+	}
+	if resource.InternalPath == "" {
+		_, err = writer.Write(resource.Blob)
+		return err
+	}
+	src, err := os.Open(resource.InternalPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open the local resource: %s", resource.InternalPath)
+	}
+	defer src.Close()
+	_, err = io.Copy(writer, src)
+	return err
+}
+
+// importedRows are the rows created by an import, which are deleted when the import fails half-way.
+type importedRows struct {
+	resourceIDs     []int32
+	memoIDs         []int32
+	relationTypeIDs []int32
+}
+
+// importUserData creates the rows of the archive for the user.
+// The store has no transaction across the rows, so the created rows are deleted when the import fails.
+// The tags and settings update the existing rows, so they are imported last when nothing else can fail.
+func (s *APIV1Service) importUserData(ctx context.Context, userID int32, zipReader *zip.Reader, manifest *ExportManifest, importMemos []*importMemo) (_ *ImportResult, err error) {
+	result := &ImportResult{}
+	rows := &importedRows{}
+	defer func() {
+		if err != nil {
+			s.deleteImportedRows(context.WithoutCancel(ctx), rows)
+		}
+	}()
+
+	// The resources are created first, so the links to them can be rewritten in the memo content.
+	resourceIDMap := map[int32]int32{}
+	resourceMemoIDMap := map[int32]int32{}
+	maxResourceSize := int64(s.getMaxUploadSizeBytes(ctx))
+	for _, exportResource := range manifest.Resources {
+		create := &store.Resource{
+			CreatorID:    userID,
+			CreatedTs:    exportResource.CreatedTs,
+			Filename:     exportResource.Filename,
+			ExternalLink: exportResource.ExternalLink,
+			Type:         exportResource.Type,
+			Size:         exportResource.Size,
+		}
+		if exportResource.Path != "" {
+			file, err := openZipFile(zipReader, exportResource.Path, maxResourceSize)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to open resource %s", exportResource.Path)
+			}
+			err = SaveResourceBlob(ctx, s.Store, create, file)
+			file.Close()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to save resource %s", exportResource.Path)
+			}
+		}
+		resource, err := s.Store.CreateResource(ctx, create)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create resource")
+		}
+		rows.resourceIDs = append(rows.resourceIDs, resource.ID)
+		resourceIDMap[exportResource.ID] = resource.ID
+		if exportResource.MemoID != nil {
+			resourceMemoIDMap[resource.ID] = *exportResource.MemoID
+		}
+		result.ResourceCount++
+	}
+
+	// The links to the memos in the archive are rewritten to their new IDs, so the memos link to the imported ones.
+	// The links to the memos created later are only known after they are created, so they are rewritten afterwards.
+	// The other links would resolve to the unrelated memos with the same IDs here, so they are kept as plain text.
+	memoIDMap := map[int32]int32{}
+	createdMemos := []*store.Memo{}
+	for _, importMemo := range importMemos {
+		frontMatter := importMemo.FrontMatter
+		memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+			CreatorID:  userID,
+			Content:    replaceMemoLinks(replaceResourceLinks(importMemo.Content, resourceIDMap), memoIDMap),
+			Visibility: frontMatter.Visibility,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create memo")
+		}
+		rows.memoIDs = append(rows.memoIDs, memo.ID)
+		createdMemos = append(createdMemos, memo)
+		update := &store.UpdateMemo{
+			ID:        memo.ID,
+			RowStatus: &frontMatter.RowStatus,
+		}
+		if frontMatter.CreatedTs != 0 {
+			update.CreatedTs = &frontMatter.CreatedTs
+		}
+		if frontMatter.UpdatedTs != 0 {
+			update.UpdatedTs = &frontMatter.UpdatedTs
+		}
+		if err := s.Store.UpdateMemo(ctx, update); err != nil {
+			return nil, errors.Wrap(err, "failed to update memo")
+		}
+		if frontMatter.Pinned {
+			if _, err := s.Store.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+				MemoID: memo.ID,
+				UserID: userID,
+				Pinned: true,
+			}); err != nil {
+				return nil, errors.Wrap(err, "failed to pin memo")
+			}
+		}
+		if frontMatter.ID != 0 {
+			memoIDMap[frontMatter.ID] = memo.ID
+		}
+		result.MemoCount++
+	}
+
+	for i, importMemo := range importMemos {
+		content := replaceMemoLinks(replaceResourceLinks(importMemo.Content, resourceIDMap), memoIDMap)
+		if content == createdMemos[i].Content {
+			continue
+		}
+		// The content written in the first pass is incomplete, so it is not kept as a revision.
+		update := &store.UpdateMemo{
+			ID:           createdMemos[i].ID,
+			Content:      &content,
+			SkipRevision: true,
+		}
+		if importMemo.FrontMatter.UpdatedTs != 0 {
+			update.UpdatedTs = &importMemo.FrontMatter.UpdatedTs
+		}
+		if err := s.Store.UpdateMemo(ctx, update); err != nil {
+			return nil, errors.Wrap(err, "failed to update memo links")
+		}
+	}
+
+	for resourceID, memoID := range resourceMemoIDMap {
+		newMemoID, ok := memoIDMap[memoID]
+		if !ok {
+			continue
+		}
+		if _, err := s.Store.UpdateResource(ctx, &store.UpdateResource{
+			ID:     resourceID,
+			MemoID: &newMemoID,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to update resource")
+		}
+	}
+
+	// The custom relation types are created before the relations, and the existing ones are kept as they are.
+	for _, relationType := range manifest.RelationTypes {
+		definition, err := s.Store.GetMemoRelationTypeDefinition(ctx, &store.FindMemoRelationTypeDefinition{
+			CreatorID: &userID,
+			Name:      &relationType.Name,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find memo relation type")
+		}
+		if definition != nil {
+			continue
+		}
+		definition, err = s.Store.CreateMemoRelationTypeDefinition(ctx, &store.MemoRelationTypeDefinition{
+			CreatorID:   userID,
+			Name:        relationType.Name,
+			InverseName: relationType.InverseName,
+			Directed:    relationType.Directed,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create memo relation type")
+		}
+		rows.relationTypeIDs = append(rows.relationTypeIDs, definition.ID)
+	}
+
+	// The relations to the memos outside the archive, e.g. of other users, are dropped.
+	for _, importMemo := range importMemos {
+		for _, relation := range importMemo.FrontMatter.Relations {
+			memoID, ok := memoIDMap[importMemo.FrontMatter.ID]
+			if !ok {
+				continue
+			}
+			relatedMemoID, ok := memoIDMap[relation.RelatedMemoID]
+			if !ok {
+				continue
+			}
+			if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+				MemoID:        memoID,
+				RelatedMemoID: relatedMemoID,
+				Type:          relation.Type,
+			}); err != nil {
+				return nil, errors.Wrap(err, "failed to create memo relation")
+			}
+			result.RelationCount++
+		}
+	}
+
+	if err := s.importTags(ctx, userID, manifest.Tags); err != nil {
+		return nil, err
+	}
+	result.TagCount = len(manifest.Tags)
+
+	for _, value := range manifest.Settings {
+		userSetting := &storepb.UserSetting{}
+		if err := protojson.Unmarshal(value, userSetting); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal user setting")
+		}
+		if !isPortableUserSetting(userSetting.Key) {
+			continue
+		}
+		userSetting.UserId = userID
+		if _, err := s.Store.UpsertUserSettingV1(ctx, userSetting); err != nil {
+			return nil, errors.Wrap(err, "failed to upsert user setting")
+		}
+		result.SettingCount++
+	}
+	return result, nil
+}
+
+// deleteImportedRows deletes the rows created by a failed import, with the relations and pins of the memos.
+func (s *APIV1Service) deleteImportedRows(ctx context.Context, rows *importedRows) {
+	for _, memoID := range rows.memoIDs {
+		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memoID}); err != nil {
+			log.Warn("Failed to delete imported memo", zap.Int32("memoID", memoID), zap.Error(err))
+		}
+	}
+	for _, resourceID := range rows.resourceIDs {
+		if err := s.Store.DeleteResource(ctx, &store.DeleteResource{ID: resourceID}); err != nil {
+			log.Warn("Failed to delete imported resource", zap.Int32("resourceID", resourceID), zap.Error(err))
+		}
+	}
+	for _, relationTypeID := range rows.relationTypeIDs {
+		if err := s.Store.DeleteMemoRelationTypeDefinition(ctx, &store.DeleteMemoRelationTypeDefinition{ID: relationTypeID}); err != nil {
+			log.Warn("Failed to delete imported memo relation type", zap.Int32("relationTypeID", relationTypeID), zap.Error(err))
+		}
+	}
+}
+
+// importTags creates the tags with their metadata, which replaces the metadata of the existing tags.
+// The aliases used by the other tags of the user are dropped, as an alias belongs to one tag.
+func (s *APIV1Service) importTags(ctx context.Context, userID int32, exportTags []*ExportTag) error {
+	for _, exportTag := range exportTags {
+		if _, err := s.Store.UpsertTag(ctx, &store.Tag{
+			Name:      exportTag.Name,
+			CreatorID: userID,
+		}); err != nil {
+			return errors.Wrap(err, "failed to create tag")
+		}
+	}
+
+	tagList, err := s.Store.ListTags(ctx, &store.FindTag{
+		CreatorID: userID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list tags")
+	}
+	usedNames := make(map[string]string)
+	for _, tag := range tagList {
+		usedNames[tag.Name] = tag.Name
+		for _, alias := range tag.Aliases {
+			usedNames[alias] = tag.Name
+		}
+	}
+	for _, exportTag := range exportTags {
+		if exportTag.nameOnly {
+			continue
+		}
+		aliases := []string{}
+		for _, alias := range exportTag.Aliases {
+			if name, ok := usedNames[alias]; (ok && name != exportTag.Name) || !store.IsValidTag(alias) {
+				continue
+			}
+			usedNames[alias] = exportTag.Name
+			aliases = append(aliases, alias)
+		}
+		if _, err := s.Store.UpsertTag(ctx, &store.Tag{
+			Name:      exportTag.Name,
+			CreatorID: userID,
+			Payload: &storepb.TagPayload{
+				Color:       exportTag.Color,
+				Icon:        exportTag.Icon,
+				Description: exportTag.Description,
+				SortWeight:  exportTag.SortWeight,
+				Favorite:    exportTag.Favorite,
+			},
+			Aliases: aliases,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to update tag %s", exportTag.Name)
+		}
+	}
+	return nil
+}
+
+type importMemo struct {
+	FrontMatter *MemoFrontMatter
+	Content     string
+}
+
+// readExportManifest reads and validates the manifest, whose resource files must be in the archive
+// and no larger than maxResourceSize.
+func readExportManifest(zipReader *zip.Reader, maxResourceSize int64) (*ExportManifest, error) {
+	file, err := openZipFile(zipReader, exportManifestName, maxManifestSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open manifest")
+	}
+	defer file.Close()
+
+	manifest := &ExportManifest{}
+	if err := json.NewDecoder(file).Decode(manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to decode manifest")
+	}
+	if manifest.Version != exportArchiveVersion {
+		return nil, errors.Errorf("unsupported archive version %d", manifest.Version)
+	}
+	for _, resource := range manifest.Resources {
+		if resource.Path == "" && resource.ExternalLink == "" {
+			return nil, errors.Errorf("resource %d has neither file nor external link", resource.ID)
+		}
+		if resource.Path != "" {
+			file, err := openZipFile(zipReader, resource.Path, maxResourceSize)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid resource %d", resource.ID)
+			}
+			file.Close()
+		}
+	}
+	for _, tag := range manifest.Tags {
+		if !store.IsValidTag(tag.Name) {
+			return nil, errors.Errorf("invalid tag %q", tag.Name)
+		}
+	}
+	for _, relationType := range manifest.RelationTypes {
+		if !store.IsValidMemoRelationTypeName(relationType.Name) {
+			return nil, errors.Errorf("invalid relation type %q", relationType.Name)
+		}
+	}
+	return manifest, nil
+}
+
+// openZipFile opens the file in the archive, which is rejected when its declared size is larger than the limit.
+// The reader is limited as well, so no more than the limit is decompressed whatever the archive declares.
+func openZipFile(zipReader *zip.Reader, name string, limit int64) (io.ReadCloser, error) {
+	file, err := zipReader.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.Size() > limit {
+		file.Close()
+		return nil, errors.Errorf("%s is larger than %d bytes", name, limit)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(file, limit), file}, nil
+}
+
+// readExportMemos reads the memos in the archive ordered by their creation time,
+// so the new IDs keep the order of the original ones.
+// The relations may use the built-in types or the custom types of the manifest.
+func readExportMemos(zipReader *zip.Reader, manifest *ExportManifest) ([]*importMemo, error) {
+	relationTypes := map[store.MemoRelationType]bool{}
+	for _, relationType := range manifest.RelationTypes {
+		relationTypes[store.MemoRelationType(relationType.Name)] = true
+	}
+	importMemos := []*importMemo{}
+	memoIDs := map[int32]bool{}
+	for _, file := range zipReader.File {
+		if !strings.HasPrefix(file.Name, exportMemoDir) || path.Ext(file.Name) != ".md" {
+			continue
+		}
+		reader, err := openZipFile(zipReader, file.Name, maxMemoFileSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid memo %s", file.Name)
+		}
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, err
+		}
+		frontMatter, content, err := parseMemoMarkdown(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid memo %s", file.Name)
+		}
+		for _, relation := range frontMatter.Relations {
+			if !store.IsBuiltinMemoRelationType(relation.Type) && !relationTypes[relation.Type] {
+				return nil, errors.Errorf("invalid relation type %q in memo %s", relation.Type, file.Name)
+			}
+		}
+		if frontMatter.ID != 0 {
+			if memoIDs[frontMatter.ID] {
+				return nil, errors.Errorf("duplicated memo id %d", frontMatter.ID)
+			}
+			memoIDs[frontMatter.ID] = true
+		}
+		importMemos = append(importMemos, &importMemo{
+			FrontMatter: frontMatter,
+			Content:     content,
+		})
+	}
+	sort.SliceStable(importMemos, func(i, j int) bool {
+		if importMemos[i].FrontMatter.CreatedTs != importMemos[j].FrontMatter.CreatedTs {
+			return importMemos[i].FrontMatter.CreatedTs < importMemos[j].FrontMatter.CreatedTs
+		}
+		return importMemos[i].FrontMatter.ID < importMemos[j].FrontMatter.ID
+	})
+	return importMemos, nil
+}
+
+func writeExportMemo(zipWriter *zip.Writer, frontMatter *MemoFrontMatter, content string) error {
+	data, err := yaml.Marshal(frontMatter)
+	if err != nil {
+		return err
+	}
+	writer, err := zipWriter.CreateHeader(&zip.FileHeader{
+		Name:     fmt.Sprintf("%s%d.md", exportMemoDir, frontMatter.ID),
+		Method:   zip.Deflate,
+		Modified: time.Unix(frontMatter.UpdatedTs, 0),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(writer, frontMatterFence+string(data)+frontMatterFence+content)
+	return err
+}
+
+// parseMemoMarkdown splits a memo file into its front matter and content.
+// A file without front matter is imported as a new private memo.
+func parseMemoMarkdown(data []byte) (*MemoFrontMatter, string, error) {
+	frontMatter := &MemoFrontMatter{
+		Visibility: store.Private,
+		RowStatus:  store.Normal,
+	}
+	content := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if strings.HasPrefix(content, frontMatterFence) {
+		end := strings.Index(content[len(frontMatterFence):], "\n"+frontMatterFence)
+		if end < 0 {
+			return nil, "", errors.New("front matter is not closed")
+		}
+		end += len(frontMatterFence)
+		if err := yaml.Unmarshal([]byte(content[len(frontMatterFence):end+1]), frontMatter); err != nil {
+			return nil, "", errors.Wrap(err, "failed to parse front matter")
+		}
+		content = content[end+1+len(frontMatterFence):]
+	}
+
+	switch frontMatter.Visibility {
+	case store.Public, store.Protected, store.Private:
+	default:
+		return nil, "", errors.Errorf("invalid visibility %q", frontMatter.Visibility)
+	}
+	switch frontMatter.RowStatus {
+	case store.Normal, store.Archived:
+	default:
+		return nil, "", errors.Errorf("invalid row status %q", frontMatter.RowStatus)
+	}
+	return frontMatter, content, nil
+}
+
+// replaceResourceLinks rewrites the links to the imported resources with their new IDs.
+func replaceResourceLinks(content string, resourceIDMap map[int32]int32) string {
+	return resourceLinkMatcher.ReplaceAllStringFunc(content, func(link string) string {
+		id, err := strconv.ParseInt(link[len("/o/r/"):], 10, 32)
+		if err != nil {
+			return link
+		}
+		newID, ok := resourceIDMap[int32(id)]
+		if !ok {
+			return link
+		}
+		return fmt.Sprintf("/o/r/%d", newID)
+	})
+}
+
+// replaceMemoLinks rewrites the links to the imported memos with their new IDs.
+// The links to the memos which are not imported are replaced with their plain text, e.g. "memo:12", so they link to nothing.
+func replaceMemoLinks(content string, memoIDMap map[int32]int32) string {
+	return memoLinkMatcher.ReplaceAllStringFunc(content, func(link string) string {
+		matches := memoLinkMatcher.FindStringSubmatch(link)
+		id, err := strconv.ParseInt(matches[1], 10, 32)
+		if err != nil {
+			return "memo:" + matches[1]
+		}
+		newID, ok := memoIDMap[int32(id)]
+		if !ok {
+			return "memo:" + matches[1]
+		}
+		return fmt.Sprintf("[[memo:%d]]", newID)
+	})
+}
+
+// isPortableUserSetting reports whether the user setting can be moved to another instance.
+// The access tokens and the linked Telegram account belong to the instance they are created on.
+func isPortableUserSetting(key storepb.UserSettingKey) bool {
+	return key != storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS && key != storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportTagUnmarshalJSON(t *testing.T) {
+	manifest := &ExportManifest{}
+	err := json.Unmarshal([]byte(`{"version":1,"tags":["reading",{"name":"work","color":"#16a34a","sortWeight":10,"favorite":true,"aliases":["job"]}]}`), manifest)
+	require.NoError(t, err)
+	require.Equal(t, []*ExportTag{
+		{Name: "reading", nameOnly: true},
+		{Name: "work", Color: "#16a34a", SortWeight: 10, Favorite: true, Aliases: []string{"job"}},
+	}, manifest.Tags)
+}
@@ -58,6 +58,7 @@ type Memo struct {
 	Content    string     `json:"content"`
 	Visibility Visibility `json:"visibility"`
 	Pinned     bool       `json:"pinned"`
+	Snippet    string     `json:"snippet,omitempty"`
 
 	// Related fields
 	Parent          *Memo           `json:"parent"`
@@ -65,6 +66,8 @@ type Memo struct {
 	CreatorUsername string          `json:"creatorUsername"`
 	ResourceList    []*Resource     `json:"resourceList"`
 	RelationList    []*MemoRelation `json:"relationList"`
+	// UnresolvedLinkList is the targets of the wiki links which match no memo, only set in the responses of the saves.
+	UnresolvedLinkList []string `json:"unresolvedLinkList,omitempty"`
 }
 
 type CreateMemoRequest struct {
@@ -139,11 +142,13 @@ func (s *APIV1Service) registerMemoRoutes(g *echo.Group) {
 //	@Param		pinned			query		bool			false	"Pinned"
 //	@Param		tag				query		string			false	"Search for tag. Do not append #"
 //	@Param		content			query		string			false	"Search for content"
+//	@Param		search			query		string			false	"Full-text search query, ordered by relevance"
 //	@Param		limit			query		int				false	"Limit"
 //	@Param		offset			query		int				false	"Offset"
 //	@Success	200				{object}	[]store.Memo	"Memo list"
 //	@Failure	400				{object}	nil				"Missing user to find memo"
 //	@Failure	500				{object}	nil				"Failed to get memo display with updated ts setting value | Failed to fetch memo list | Failed to compose memo response"
+//	@Failure	501				{object}	nil				"Full-text search is not supported by the database"
 //	@Router		/api/v1/memo [GET]
 func (s *APIV1Service) GetMemoList(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -191,15 +196,17 @@ func (s *APIV1Service) GetMemoList(c echo.Context) error {
 	}
 
 	contentSearch := []string{}
-	tag := c.QueryParam("tag")
-	if tag != "" {
-		contentSearch = append(contentSearch, "#"+tag)
+	if tag := c.QueryParam("tag"); tag != "" {
+		find.Tag = &tag
 	}
 	content := c.QueryParam("content")
 	if content != "" {
 		contentSearch = append(contentSearch, content)
 	}
 	find.ContentSearch = contentSearch
+	if search := c.QueryParam("search"); search != "" {
+		find.SearchQuery = &search
+	}
 
 	if limit, err := strconv.Atoi(c.QueryParam("limit")); err == nil {
 		find.Limit = &limit
@@ -218,6 +225,9 @@ func (s *APIV1Service) GetMemoList(c echo.Context) error {
 
 	list, err := s.Store.ListMemos(ctx, find)
 	if err != nil {
+		if errors.Is(err, store.ErrMemoSearchNotSupported) {
+			return echo.NewHTTPError(http.StatusNotImplemented, "Full-text search is not supported by the database").SetInternal(err)
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to fetch memo list").SetInternal(err)
 	}
 	memoResponseList := []*Memo{}
@@ -236,6 +246,7 @@ func (s *APIV1Service) GetMemoList(c echo.Context) error {
 //	@Summary		Create a memo
 //	@Description	Visibility can be PUBLIC, PROTECTED or PRIVATE
 //	@Description	*You should omit fields to use their default values
+//	@Description	The [[memo:123]] and [[Title text]] links in the content become reference relations, and the unresolved ones are listed in unresolvedLinkList
 //	@Tags			memo
 //	@Accept			json
 //	@Produce		json
@@ -244,7 +255,7 @@ func (s *APIV1Service) GetMemoList(c echo.Context) error {
 //	@Failure		400		{object}	nil					"Malformatted post memo request | Content size overflow, up to 1MB"
 //	@Failure		401		{object}	nil					"Missing user in session"
 //	@Failure		404		{object}	nil					"User not found | Memo not found: %d"
-//	@Failure		500		{object}	nil					"Failed to find user setting | Failed to unmarshal user setting value | Failed to find system setting | Failed to unmarshal system setting | Failed to find user | Failed to create memo | Failed to create activity | Failed to upsert memo resource | Failed to upsert memo relation | Failed to compose memo | Failed to compose memo response"
+//	@Failure		500		{object}	nil					"Failed to find user setting | Failed to unmarshal user setting value | Failed to find system setting | Failed to unmarshal system setting | Failed to find user | Failed to create memo | Failed to create activity | Failed to upsert memo resource | Failed to upsert memo relation | Failed to resolve memo links | Failed to compose memo | Failed to compose memo response"
 //	@Router			/api/v1/memo [POST]
 //
 // NOTES:
@@ -374,6 +385,11 @@ func (s *APIV1Service) CreateMemo(c echo.Context) error {
 		}
 	}
 
+	unresolvedLinkList, err := s.Store.ListUnresolvedMemoLinks(ctx, memo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resolve memo links").SetInternal(err)
+	}
+
 	composedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
 		ID: &memo.ID,
 	})
@@ -389,6 +405,8 @@ func (s *APIV1Service) CreateMemo(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to compose memo response").SetInternal(err)
 	}
 
+	memoResponse.UnresolvedLinkList = unresolvedLinkList
+
 	if err := s.DispatchMemoCreatedWebhook(ctx, memoResponse); err != nil {
 		log.Warn("Failed to dispatch memo created webhook", zap.Error(err))
 	}
@@ -632,7 +650,7 @@ func (s *APIV1Service) DeleteMemo(c echo.Context) error {
 //	@Failure		400		{object}	nil					"ID is not a number: %s | Malformatted patch memo request | Content size overflow, up to 1MB"
 //	@Failure		401		{object}	nil					"Missing user in session | Unauthorized"
 //	@Failure		404		{object}	nil					"Memo not found: %d"
-//	@Failure		500		{object}	nil					"Failed to find memo | Failed to patch memo | Failed to upsert memo resource | Failed to delete memo resource | Failed to compose memo response"
+//	@Failure		500		{object}	nil					"Failed to find memo | Failed to patch memo | Failed to upsert memo resource | Failed to delete memo resource | Failed to reconcile memo links | Failed to compose memo response"
 //	@Router			/api/v1/memo/{memoId} [PATCH]
 //
 // NOTES:
@@ -765,6 +783,11 @@ func (s *APIV1Service) UpdateMemo(c echo.Context) error {
 			}
 		}
 	}
+	// The relation list may remove the relations of the wiki links, which are restored, so they are kept whatever the relation list is.
+	unresolvedLinkList, err := s.Store.ReconcileMemoLinks(ctx, memo, memo.Content)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to reconcile memo links").SetInternal(err)
+	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
 	if err != nil {
@@ -778,6 +801,7 @@ func (s *APIV1Service) UpdateMemo(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to compose memo response").SetInternal(err)
 	}
+	memoResponse.UnresolvedLinkList = unresolvedLinkList
 	// Try to dispatch webhook when memo is updated.
 	if err := s.DispatchMemoUpdatedWebhook(ctx, memoResponse); err != nil {
 		log.Warn("Failed to dispatch memo updated webhook", zap.Error(err))
@@ -797,6 +821,7 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		Content:    memo.Content,
 		Visibility: Visibility(memo.Visibility.String()),
 		Pinned:     memo.Pinned,
+		Snippet:    memo.Snippet,
 	}
 
 	// Compose creator name.
@@ -973,23 +998,11 @@ func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *Mem
 }
 
 func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *Memo, activityType string) error {
-	webhooks, err := s.Store.ListWebhooks(ctx, &store.FindWebhook{
-		CreatorID: &memo.CreatorID,
-	})
-	if err != nil {
-		return err
-	}
 	metric.Enqueue("webhook dispatch")
-	for _, hook := range webhooks {
-		payload := convertMemoToWebhookPayload(memo)
-		payload.ActivityType = activityType
-		payload.URL = hook.Url
-		err := webhook.Post(*payload)
-		if err != nil {
-			return errors.Wrap(err, "failed to post webhook")
-		}
-	}
-	return nil
+	payload := convertMemoToWebhookPayload(memo)
+	payload.ActivityType = activityType
+	// The payload is posted in the background, so a slow receiver does not block the request.
+	return s.webhookDispatcher.DispatchMemoEvent(ctx, payload)
 }
 
 func convertMemoToWebhookPayload(memo *Memo) *webhook.WebhookPayload {
@@ -0,0 +1,182 @@
+package v1
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/usememos/memos/store"
+)
+
+// MemoGraph is the relation graph of the memos of a user.
+type MemoGraph struct {
+	Nodes []*MemoGraphNode `json:"nodes"`
+	Edges []*MemoRelation  `json:"edges"`
+}
+
+type MemoGraphNode struct {
+	ID         int32            `json:"id"`
+	CreatedTs  int64            `json:"createdTs"`
+	Visibility store.Visibility `json:"visibility"`
+	RowStatus  store.RowStatus  `json:"rowStatus"`
+	Content    string           `json:"content"`
+}
+
+// graphML is the GraphML document of a memo graph, see http://graphml.graphdrawing.org/specification.html.
+type graphML struct {
+	XMLName xml.Name      `xml:"graphml"`
+	XMLNS   string        `xml:"xmlns,attr"`
+	Keys    []*graphMLKey `xml:"key"`
+	Graph   *graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string         `xml:"id,attr"`
+	EdgeDefault string         `xml:"edgedefault,attr"`
+	Nodes       []*graphMLNode `xml:"node"`
+	Edges       []*graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string         `xml:"id,attr"`
+	Data []*graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string         `xml:"source,attr"`
+	Target string         `xml:"target,attr"`
+	Data   []*graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// ExportMemoGraph godoc
+//
+//	@Summary		Export the relation graph of the memos of the current user
+//	@Description	The nodes are the memos of the current user and the edges are the relations between them, so the memos of other users never show up.
+//	@Tags			memo-relation
+//	@Produce		json,xml
+//	@Param			format	query		string		false	"Format of the graph, json (default) or graphml"
+//	@Success		200		{object}	MemoGraph	"Memo graph"
+//	@Failure		400		{object}	nil			"Unsupported graph format: %s"
+//	@Failure		401		{object}	nil			"Missing user in session"
+//	@Failure		500		{object}	nil			"Failed to export memo graph"
+//	@Router			/api/v1/memo/graph [GET]
+func (s *APIV1Service) ExportMemoGraph(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+	format := c.QueryParam("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "graphml" {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unsupported graph format: %s", format))
+	}
+
+	memoList, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to export memo graph").SetInternal(err)
+	}
+	graph := &MemoGraph{
+		Nodes: []*MemoGraphNode{},
+		Edges: []*MemoRelation{},
+	}
+	memoIDs := map[int32]bool{}
+	for _, memo := range memoList {
+		memoIDs[memo.ID] = true
+		graph.Nodes = append(graph.Nodes, &MemoGraphNode{
+			ID:         memo.ID,
+			CreatedTs:  memo.CreatedTs,
+			Visibility: memo.Visibility,
+			RowStatus:  memo.RowStatus,
+			Content:    memo.Content,
+		})
+	}
+	memoRelationList, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to export memo graph").SetInternal(err)
+	}
+	for _, memoRelation := range memoRelationList {
+		// Skip the relations to the memos of other users, which may be private.
+		if memoIDs[memoRelation.RelatedMemoID] {
+			graph.Edges = append(graph.Edges, convertMemoRelationFromStore(memoRelation))
+		}
+	}
+
+	filename := fmt.Sprintf("memos-graph-%s.%s", time.Now().Format("20060102150405"), format)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	if format == "graphml" {
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationXMLCharsetUTF8)
+		c.Response().WriteHeader(http.StatusOK)
+		if _, err := c.Response().Write([]byte(xml.Header)); err != nil {
+			return err
+		}
+		encoder := xml.NewEncoder(c.Response())
+		encoder.Indent("", "  ")
+		return encoder.Encode(convertMemoGraphToGraphML(graph))
+	}
+	return c.JSON(http.StatusOK, graph)
+}
+
+func convertMemoGraphToGraphML(graph *MemoGraph) *graphML {
+	document := &graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []*graphMLKey{
+			{ID: "createdTs", For: "node", AttrName: "createdTs", AttrType: "long"},
+			{ID: "visibility", For: "node", AttrName: "visibility", AttrType: "string"},
+			{ID: "rowStatus", For: "node", AttrName: "rowStatus", AttrType: "string"},
+			{ID: "content", For: "node", AttrName: "content", AttrType: "string"},
+			{ID: "type", For: "edge", AttrName: "type", AttrType: "string"},
+		},
+		Graph: &graphMLGraph{
+			ID:          "memos",
+			EdgeDefault: "directed",
+		},
+	}
+	for _, node := range graph.Nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, &graphMLNode{
+			ID: graphMLNodeID(node.ID),
+			Data: []*graphMLData{
+				{Key: "createdTs", Value: strconv.FormatInt(node.CreatedTs, 10)},
+				{Key: "visibility", Value: node.Visibility.String()},
+				{Key: "rowStatus", Value: node.RowStatus.String()},
+				{Key: "content", Value: node.Content},
+			},
+		})
+	}
+	for _, edge := range graph.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, &graphMLEdge{
+			Source: graphMLNodeID(edge.MemoID),
+			Target: graphMLNodeID(edge.RelatedMemoID),
+			Data: []*graphMLData{
+				{Key: "type", Value: edge.Type.String()},
+			},
+		})
+	}
+	return document
+}
+
+func graphMLNodeID(memoID int32) string {
+	return fmt.Sprintf("memo-%d", memoID)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/store"
@@ -34,6 +35,8 @@ type UpsertMemoRelationRequest struct {
 }
 
 func (s *APIV1Service) registerMemoRelationRoutes(g *echo.Group) {
+	g.GET("/memo/graph", s.ExportMemoGraph)
+	s.registerMemoRelationTypeRoutes(g)
 	g.GET("/memo/:memoId/relation", s.GetMemoRelationList)
 	g.POST("/memo/:memoId/relation", s.CreateMemoRelation)
 	g.DELETE("/memo/:memoId/relation/:relatedMemoId/type/:relationType", s.DeleteMemoRelation)
@@ -46,6 +49,7 @@ func (s *APIV1Service) registerMemoRelationRoutes(g *echo.Group) {
 //	@Accept		json
 //	@Produce	json
 //	@Param		memoId	path		int						true	"ID of memo to find relations"
+//	@Param		type	query		[]string				false	"Types of the relations, builtin or custom"	collectionFormat(multi)
 //	@Success	200		{object}	[]store.MemoRelation	"Memo relation information list"
 //	@Failure	400		{object}	nil						"ID is not a number: %s"
 //	@Failure	500		{object}	nil						"Failed to list memo relations"
@@ -57,9 +61,13 @@ func (s *APIV1Service) GetMemoRelationList(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", c.Param("memoId"))).SetInternal(err)
 	}
 
-	memoRelationList, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+	find := &store.FindMemoRelation{
 		MemoID: &memoID,
-	})
+	}
+	for _, relationType := range c.QueryParams()["type"] {
+		find.TypeList = append(find.TypeList, store.MemoRelationType(relationType))
+	}
+	memoRelationList, err := s.Store.ListMemoRelations(ctx, find)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list memo relations").SetInternal(err)
 	}
@@ -76,7 +84,7 @@ func (s *APIV1Service) GetMemoRelationList(c echo.Context) error {
 //	@Param			memoId	path		int							true	"ID of memo to relate"
 //	@Param			body	body		UpsertMemoRelationRequest	true	"Memo relation object"
 //	@Success		200		{object}	store.MemoRelation			"Memo relation information"
-//	@Failure		400		{object}	nil							"ID is not a number: %s | Malformatted post memo relation request"
+//	@Failure		400		{object}	nil							"ID is not a number: %s | Malformatted post memo relation request | Invalid memo relation"
 //	@Failure		500		{object}	nil							"Failed to upsert memo relation"
 //	@Router			/api/v1/memo/{memoId}/relation [POST]
 //
@@ -96,12 +104,16 @@ func (s *APIV1Service) CreateMemoRelation(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted post memo relation request").SetInternal(err)
 	}
 
-	memoRelation, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+	create := &store.MemoRelation{
 		MemoID:        memoID,
 		RelatedMemoID: request.RelatedMemoID,
 		Type:          store.MemoRelationType(request.Type),
-	})
+	}
+	memoRelation, err := s.Store.UpsertMemoRelation(ctx, create)
 	if err != nil {
+		if errors.Is(err, store.ErrInvalidMemoRelation) {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid memo relation").SetInternal(err)
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to upsert memo relation").SetInternal(err)
 	}
 	return c.JSON(http.StatusOK, memoRelation)
@@ -0,0 +1,234 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/store"
+)
+
+// MemoRelationTypeDefinition is a custom memo relation type of a user.
+type MemoRelationTypeDefinition struct {
+	ID        int32 `json:"id"`
+	CreatorID int32 `json:"creatorId"`
+	CreatedTs int64 `json:"createdTs"`
+
+	Name        string `json:"name"`
+	InverseName string `json:"inverseName"`
+	Directed    bool   `json:"directed"`
+}
+
+type CreateMemoRelationTypeDefinitionRequest struct {
+	Name        string `json:"name"`
+	InverseName string `json:"inverseName"`
+	// Directed defaults to true.
+	Directed *bool `json:"directed"`
+}
+
+type PatchMemoRelationTypeDefinitionRequest struct {
+	InverseName *string `json:"inverseName"`
+}
+
+func (s *APIV1Service) registerMemoRelationTypeRoutes(g *echo.Group) {
+	g.GET("/memo/relation-type", s.GetMemoRelationTypeDefinitionList)
+	g.POST("/memo/relation-type", s.CreateMemoRelationTypeDefinition)
+	g.PATCH("/memo/relation-type/:id", s.UpdateMemoRelationTypeDefinition)
+	g.DELETE("/memo/relation-type/:id", s.DeleteMemoRelationTypeDefinition)
+}
+
+// GetMemoRelationTypeDefinitionList godoc
+//
+//	@Summary	Get the custom memo relation types of the current user
+//	@Tags		memo-relation
+//	@Produce	json
+//	@Success	200	{object}	[]MemoRelationTypeDefinition	"Memo relation type list"
+//	@Failure	401	{object}	nil								"Missing user in session"
+//	@Failure	500	{object}	nil								"Failed to list memo relation types"
+//	@Router		/api/v1/memo/relation-type [GET]
+func (s *APIV1Service) GetMemoRelationTypeDefinitionList(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	definitionList, err := s.Store.ListMemoRelationTypeDefinitions(ctx, &store.FindMemoRelationTypeDefinition{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list memo relation types").SetInternal(err)
+	}
+	list := []*MemoRelationTypeDefinition{}
+	for _, definition := range definitionList {
+		list = append(list, convertMemoRelationTypeDefinitionFromStore(definition))
+	}
+	return c.JSON(http.StatusOK, list)
+}
+
+// CreateMemoRelationTypeDefinition godoc
+//
+//	@Summary		Create a custom memo relation type
+//	@Description	The name is lowercase, e.g. "supports" or "source-of". The undirected types have no inverse name, as their relations read the same from both memos.
+//	@Tags			memo-relation
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CreateMemoRelationTypeDefinitionRequest	true	"Memo relation type"
+//	@Success		200		{object}	MemoRelationTypeDefinition				"Created memo relation type"
+//	@Failure		400		{object}	nil										"Malformatted post memo relation type request | Invalid memo relation type name: %s | Undirected memo relation types have no inverse name"
+//	@Failure		401		{object}	nil										"Missing user in session"
+//	@Failure		409		{object}	nil										"Memo relation type already exists: %s"
+//	@Failure		500		{object}	nil										"Failed to find memo relation type | Failed to create memo relation type"
+//	@Router			/api/v1/memo/relation-type [POST]
+func (s *APIV1Service) CreateMemoRelationTypeDefinition(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+
+	request := &CreateMemoRelationTypeDefinitionRequest{}
+	if err := json.NewDecoder(c.Request().Body).Decode(request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted post memo relation type request").SetInternal(err)
+	}
+	if !store.IsValidMemoRelationTypeName(request.Name) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid memo relation type name: %s", request.Name))
+	}
+	directed := request.Directed == nil || *request.Directed
+	if !directed && request.InverseName != "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Undirected memo relation types have no inverse name")
+	}
+	definition, err := s.Store.GetMemoRelationTypeDefinition(ctx, &store.FindMemoRelationTypeDefinition{
+		CreatorID: &userID,
+		Name:      &request.Name,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo relation type").SetInternal(err)
+	}
+	if definition != nil {
+		return echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("Memo relation type already exists: %s", request.Name))
+	}
+
+	definition, err = s.Store.CreateMemoRelationTypeDefinition(ctx, &store.MemoRelationTypeDefinition{
+		CreatorID:   userID,
+		Name:        request.Name,
+		InverseName: request.InverseName,
+		Directed:    directed,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create memo relation type").SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, convertMemoRelationTypeDefinitionFromStore(definition))
+}
+
+// UpdateMemoRelationTypeDefinition godoc
+//
+//	@Summary		Update a custom memo relation type
+//	@Description	Only the inverse name can be updated, as the name and the directedness are kept in the relations.
+//	@Tags			memo-relation
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int										true	"Memo relation type ID"
+//	@Param			body	body		PatchMemoRelationTypeDefinitionRequest	true	"Patched fields"
+//	@Success		200		{object}	MemoRelationTypeDefinition				"Updated memo relation type"
+//	@Failure		400		{object}	nil										"ID is not a number: %s | Malformatted patch memo relation type request | Undirected memo relation types have no inverse name"
+//	@Failure		401		{object}	nil										"Missing user in session | Unauthorized"
+//	@Failure		404		{object}	nil										"Memo relation type not found: %d"
+//	@Failure		500		{object}	nil										"Failed to find memo relation type | Failed to update memo relation type"
+//	@Router			/api/v1/memo/relation-type/{id} [PATCH]
+func (s *APIV1Service) UpdateMemoRelationTypeDefinition(c echo.Context) error {
+	ctx := c.Request().Context()
+	definition, err := s.getMemoRelationTypeDefinitionOwnedByCurrentUser(c)
+	if err != nil {
+		return err
+	}
+
+	request := &PatchMemoRelationTypeDefinitionRequest{}
+	if err := json.NewDecoder(c.Request().Body).Decode(request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted patch memo relation type request").SetInternal(err)
+	}
+	if request.InverseName == nil {
+		return c.JSON(http.StatusOK, convertMemoRelationTypeDefinitionFromStore(definition))
+	}
+	if !definition.Directed && *request.InverseName != "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Undirected memo relation types have no inverse name")
+	}
+
+	definition, err = s.Store.UpdateMemoRelationTypeDefinition(ctx, &store.UpdateMemoRelationTypeDefinition{
+		ID:          definition.ID,
+		InverseName: request.InverseName,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update memo relation type").SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, convertMemoRelationTypeDefinitionFromStore(definition))
+}
+
+// DeleteMemoRelationTypeDefinition godoc
+//
+//	@Summary		Delete a custom memo relation type
+//	@Description	The relations of the type are deleted too.
+//	@Tags			memo-relation
+//	@Produce		json
+//	@Param			id	path		int		true	"Memo relation type ID"
+//	@Success		200	{boolean}	true	"Memo relation type deleted"
+//	@Failure		400	{object}	nil		"ID is not a number: %s"
+//	@Failure		401	{object}	nil		"Missing user in session | Unauthorized"
+//	@Failure		404	{object}	nil		"Memo relation type not found: %d"
+//	@Failure		500	{object}	nil		"Failed to find memo relation type | Failed to delete memo relation type"
+//	@Router			/api/v1/memo/relation-type/{id} [DELETE]
+func (s *APIV1Service) DeleteMemoRelationTypeDefinition(c echo.Context) error {
+	ctx := c.Request().Context()
+	definition, err := s.getMemoRelationTypeDefinitionOwnedByCurrentUser(c)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Store.DeleteMemoRelationTypeDefinition(ctx, &store.DeleteMemoRelationTypeDefinition{
+		ID: definition.ID,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete memo relation type").SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, true)
+}
+
+// getMemoRelationTypeDefinitionOwnedByCurrentUser finds the relation type of the id path param and checks that it belongs to the current user.
+func (s *APIV1Service) getMemoRelationTypeDefinitionOwnedByCurrentUser(c echo.Context) (*store.MemoRelationTypeDefinition, error) {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+	id, err := util.ConvertStringToInt32(c.Param("id"))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", c.Param("id"))).SetInternal(err)
+	}
+
+	definition, err := s.Store.GetMemoRelationTypeDefinition(ctx, &store.FindMemoRelationTypeDefinition{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo relation type").SetInternal(err)
+	}
+	if definition == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Memo relation type not found: %d", id))
+	}
+	if definition.CreatorID != userID {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	return definition, nil
+}
+
+func convertMemoRelationTypeDefinitionFromStore(definition *store.MemoRelationTypeDefinition) *MemoRelationTypeDefinition {
+	return &MemoRelationTypeDefinition{
+		ID:          definition.ID,
+		CreatorID:   definition.CreatorID,
+		CreatedTs:   definition.CreatedTs,
+		Name:        definition.Name,
+		InverseName: definition.InverseName,
+		Directed:    definition.Directed,
+	}
+}
@@ -0,0 +1,267 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/usememos/memos/internal/log"
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/store"
+)
+
+type MemoRevision struct {
+	ID int32 `json:"id"`
+
+	// Standard fields
+	CreatorID int32 `json:"creatorId"`
+	CreatedTs int64 `json:"createdTs"`
+
+	// Domain specific fields
+	MemoID  int32  `json:"memoId"`
+	Content string `json:"content"`
+}
+
+type MemoRevisionDiff struct {
+	// RevisionID is the ID of the revision being compared.
+	RevisionID int32 `json:"revisionId"`
+	// CompareToRevisionID is the ID of the revision compared against, 0 means the current memo content.
+	CompareToRevisionID int32           `json:"compareToRevisionId"`
+	LineList            []util.DiffLine `json:"lineList"`
+}
+
+func (s *APIV1Service) registerMemoRevisionRoutes(g *echo.Group) {
+	g.GET("/memo/:memoId/revision", s.GetMemoRevisionList)
+	g.GET("/memo/:memoId/revision/:revisionId", s.GetMemoRevision)
+	g.GET("/memo/:memoId/revision/:revisionId/diff", s.GetMemoRevisionDiff)
+	g.POST("/memo/:memoId/revision/:revisionId/restore", s.RestoreMemoRevision)
+}
+
+// GetMemoRevisionList godoc
+//
+//	@Summary	Get a list of revisions of a memo
+//	@Tags		memo-revision
+//	@Produce	json
+//	@Param		memoId	path		int				true	"Memo ID"
+//	@Param		limit	query		int				false	"Limit"
+//	@Param		offset	query		int				false	"Offset"
+//	@Success	200		{object}	[]MemoRevision	"Memo revision list, newest first"
+//	@Failure	400		{object}	nil				"ID is not a number: %s"
+//	@Failure	401		{object}	nil				"Missing user in session | Unauthorized"
+//	@Failure	404		{object}	nil				"Memo not found: %d"
+//	@Failure	500		{object}	nil				"Failed to find memo | Failed to list memo revisions"
+//	@Router		/api/v1/memo/{memoId}/revision [GET]
+func (s *APIV1Service) GetMemoRevisionList(c echo.Context) error {
+	ctx := c.Request().Context()
+	memo, err := s.getMemoOwnedByCurrentUser(c)
+	if err != nil {
+		return err
+	}
+
+	find := &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	}
+	if limit, err := strconv.Atoi(c.QueryParam("limit")); err == nil {
+		find.Limit = &limit
+	}
+	if offset, err := strconv.Atoi(c.QueryParam("offset")); err == nil {
+		find.Offset = &offset
+	}
+	list, err := s.Store.ListMemoRevisions(ctx, find)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list memo revisions").SetInternal(err)
+	}
+	memoRevisionList := []*MemoRevision{}
+	for _, memoRevision := range list {
+		memoRevisionList = append(memoRevisionList, convertMemoRevisionFromStore(memoRevision))
+	}
+	return c.JSON(http.StatusOK, memoRevisionList)
+}
+
+// GetMemoRevision godoc
+//
+//	@Summary	Get a revision of a memo
+//	@Tags		memo-revision
+//	@Produce	json
+//	@Param		memoId		path		int				true	"Memo ID"
+//	@Param		revisionId	path		int				true	"Revision ID"
+//	@Success	200			{object}	MemoRevision	"Memo revision"
+//	@Failure	400			{object}	nil				"ID is not a number: %s"
+//	@Failure	401			{object}	nil				"Missing user in session | Unauthorized"
+//	@Failure	404			{object}	nil				"Memo not found: %d | Memo revision not found: %d"
+//	@Failure	500			{object}	nil				"Failed to find memo | Failed to find memo revision"
+//	@Router		/api/v1/memo/{memoId}/revision/{revisionId} [GET]
+func (s *APIV1Service) GetMemoRevision(c echo.Context) error {
+	memo, err := s.getMemoOwnedByCurrentUser(c)
+	if err != nil {
+		return err
+	}
+	memoRevision, err := s.getMemoRevisionFromParam(c, memo.ID, "revisionId")
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, convertMemoRevisionFromStore(memoRevision))
+}
+
+// GetMemoRevisionDiff godoc
+//
+//	@Summary		Get the line diff between two revisions of a memo
+//	@Description	Without compareTo, the revision is compared against the current memo content
+//	@Tags			memo-revision
+//	@Produce		json
+//	@Param			memoId		path		int					true	"Memo ID"
+//	@Param			revisionId	path		int					true	"Revision ID"
+//	@Param			compareTo	query		int					false	"Revision ID to compare against"
+//	@Success		200			{object}	MemoRevisionDiff	"Memo revision diff"
+//	@Failure		400			{object}	nil					"ID is not a number: %s"
+//	@Failure		401			{object}	nil					"Missing user in session | Unauthorized"
+//	@Failure		404			{object}	nil					"Memo not found: %d | Memo revision not found: %d"
+//	@Failure		500			{object}	nil					"Failed to find memo | Failed to find memo revision"
+//	@Router			/api/v1/memo/{memoId}/revision/{revisionId}/diff [GET]
+func (s *APIV1Service) GetMemoRevisionDiff(c echo.Context) error {
+	memo, err := s.getMemoOwnedByCurrentUser(c)
+	if err != nil {
+		return err
+	}
+	memoRevision, err := s.getMemoRevisionFromParam(c, memo.ID, "revisionId")
+	if err != nil {
+		return err
+	}
+
+	diff := &MemoRevisionDiff{
+		RevisionID: memoRevision.ID,
+	}
+	compareToContent := memo.Content
+	if c.QueryParam("compareTo") != "" {
+		compareToRevision, err := s.getMemoRevisionFromParam(c, memo.ID, "compareTo")
+		if err != nil {
+			return err
+		}
+		diff.CompareToRevisionID = compareToRevision.ID
+		compareToContent = compareToRevision.Content
+	}
+	diff.LineList = util.DiffLines(memoRevision.Content, compareToContent)
+	return c.JSON(http.StatusOK, diff)
+}
+
+// RestoreMemoRevision godoc
+//
+//	@Summary		Restore a revision of a memo
+//	@Description	The revision content is applied as a new update, so the replaced content is kept as a revision too
+//	@Tags			memo-revision
+//	@Produce		json
+//	@Param			memoId		path		int		true	"Memo ID"
+//	@Param			revisionId	path		int		true	"Revision ID"
+//	@Success		200			{object}	Memo	"Restored memo"
+//	@Failure		400			{object}	nil		"ID is not a number: %s"
+//	@Failure		401			{object}	nil		"Missing user in session | Unauthorized"
+//	@Failure		404			{object}	nil		"Memo not found: %d | Memo revision not found: %d"
+//	@Failure		500			{object}	nil		"Failed to find memo | Failed to find memo revision | Failed to restore memo revision | Failed to resolve memo links | Failed to compose memo response"
+//	@Router			/api/v1/memo/{memoId}/revision/{revisionId}/restore [POST]
+func (s *APIV1Service) RestoreMemoRevision(c echo.Context) error {
+	ctx := c.Request().Context()
+	memo, err := s.getMemoOwnedByCurrentUser(c)
+	if err != nil {
+		return err
+	}
+	memoRevision, err := s.getMemoRevisionFromParam(c, memo.ID, "revisionId")
+	if err != nil {
+		return err
+	}
+
+	currentTs := time.Now().Unix()
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		UpdatedTs: &currentTs,
+		Content:   &memoRevision.Content,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to restore memo revision").SetInternal(err)
+	}
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo").SetInternal(err)
+	}
+	if memo == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Memo not found: %d", memoRevision.MemoID))
+	}
+	unresolvedLinkList, err := s.Store.ListUnresolvedMemoLinks(ctx, memo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resolve memo links").SetInternal(err)
+	}
+
+	memoResponse, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to compose memo response").SetInternal(err)
+	}
+	memoResponse.UnresolvedLinkList = unresolvedLinkList
+	if err := s.DispatchMemoUpdatedWebhook(ctx, memoResponse); err != nil {
+		log.Warn("Failed to dispatch memo updated webhook", zap.Error(err))
+	}
+	return c.JSON(http.StatusOK, memoResponse)
+}
+
+// getMemoOwnedByCurrentUser finds the memo of the memoId path param and checks that it belongs to the current user.
+func (s *APIV1Service) getMemoOwnedByCurrentUser(c echo.Context) (*store.Memo, error) {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
+	}
+	memoID, err := util.ConvertStringToInt32(c.Param("memoId"))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", c.Param("memoId"))).SetInternal(err)
+	}
+
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: &memoID,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo").SetInternal(err)
+	}
+	if memo == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Memo not found: %d", memoID))
+	}
+	if memo.CreatorID != userID {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	return memo, nil
+}
+
+// getMemoRevisionFromParam finds the revision whose ID is given by the named path or query param.
+func (s *APIV1Service) getMemoRevisionFromParam(c echo.Context, memoID int32, name string) (*store.MemoRevision, error) {
+	ctx := c.Request().Context()
+	value := c.Param(name)
+	if value == "" {
+		value = c.QueryParam(name)
+	}
+	memoRevisionID, err := util.ConvertStringToInt32(value)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", value)).SetInternal(err)
+	}
+
+	memoRevision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &memoRevisionID,
+		MemoID: &memoID,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo revision").SetInternal(err)
+	}
+	if memoRevision == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Memo revision not found: %d", memoRevisionID))
+	}
+	return memoRevision, nil
+}
+
+func convertMemoRevisionFromStore(memoRevision *store.MemoRevision) *MemoRevision {
+	return &MemoRevision{
+		ID:        memoRevision.ID,
+		CreatorID: memoRevision.CreatorID,
+		CreatedTs: memoRevision.CreatedTs,
+		MemoID:    memoRevision.MemoID,
+		Content:   memoRevision.Content,
+	}
+}
@@ -182,15 +182,7 @@ func (s *APIV1Service) UploadResource(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Missing user in session")
 	}
 
-	// This is the backend default max upload size limit.
-	maxUploadSetting := s.Store.GetSystemSettingValueWithDefault(ctx, SystemSettingMaxUploadSizeMiBName.String(), "32")
-	var settingMaxUploadSizeBytes int
-	if settingMaxUploadSizeMiB, err := strconv.Atoi(maxUploadSetting); err == nil {
-		settingMaxUploadSizeBytes = settingMaxUploadSizeMiB * MebiByte
-	} else {
-		log.Warn("Failed to parse max upload size", zap.Error(err))
-		settingMaxUploadSizeBytes = 0
-	}
+	settingMaxUploadSizeBytes := s.getMaxUploadSizeBytes(ctx)
 
 	file, err := c.FormFile("file")
 	if err != nil {
@@ -375,6 +367,18 @@ func convertResourceFromStore(resource *store.Resource) *Resource {
 	}
 }
 
+// getMaxUploadSizeBytes returns the max size of a resource in the system setting.
+func (s *APIV1Service) getMaxUploadSizeBytes(ctx context.Context) int {
+	// This is the backend default max upload size limit.
+	maxUploadSetting := s.Store.GetSystemSettingValueWithDefault(ctx, SystemSettingMaxUploadSizeMiBName.String(), "32")
+	settingMaxUploadSizeMiB, err := strconv.Atoi(maxUploadSetting)
+	if err != nil {
+		log.Warn("Failed to parse max upload size", zap.Error(err))
+		return 0
+	}
+	return settingMaxUploadSizeMiB * MebiByte
+}
+
 // SaveResourceBlob save the blob of resource based on the storage config
 //
 // Depend on the storage config, some fields of *store.ResourceCreate will be changed:
@@ -12,8 +12,10 @@ import (
 
 	"github.com/gorilla/feeds"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 	"github.com/yuin/goldmark"
 
+	"github.com/usememos/memos/internal/memofilter"
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/store"
 )
@@ -24,6 +26,7 @@ const maxRSSItemTitleLength = 100
 func (s *APIV1Service) registerRSSRoutes(g *echo.Group) {
 	g.GET("/explore/rss.xml", s.GetExploreRSS)
 	g.GET("/u/:id/rss.xml", s.GetUserRSS)
+	g.GET("/u/:id/view/:name/rss.xml", s.GetUserViewRSS)
 }
 
 // GetExploreRSS godoc
@@ -102,6 +105,69 @@ func (s *APIV1Service) GetUserRSS(c echo.Context) error {
 	return c.String(http.StatusOK, rss)
 }
 
+// GetUserViewRSS godoc
+//
+//	@Summary	Get RSS for a saved search of a user
+//	@Tags		rss
+//	@Produce	xml
+//	@Param		id		path		int		true	"User ID"
+//	@Param		name	path		string	true	"Saved search name"
+//	@Success	200		{object}	nil		"RSS"
+//	@Failure	400		{object}	nil		"User id is not a number"
+//	@Failure	404		{object}	nil		"Saved search not found: %s"
+//	@Failure	500		{object}	nil		"Failed to get system customized profile | Failed to find saved search | Invalid filter of saved search | Failed to find memo list | Failed to generate rss"
+//	@Router		/u/{id}/view/{name}/rss.xml [GET]
+func (s *APIV1Service) GetUserViewRSS(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := util.ConvertStringToInt32(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "User id is not a number").SetInternal(err)
+	}
+	name := c.Param("name")
+
+	systemCustomizedProfile, err := s.getSystemCustomizedProfile(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get system customized profile").SetInternal(err)
+	}
+
+	savedSearch, err := s.Store.GetSavedSearch(ctx, &store.FindSavedSearch{
+		CreatorID: &id,
+		Name:      &name,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find saved search").SetInternal(err)
+	}
+	if savedSearch == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Saved search not found: %s", name))
+	}
+
+	// The feed is public, so it only contains the public memos of the view.
+	normalStatus := store.Normal
+	memoFind := store.FindMemo{
+		CreatorID:      &id,
+		RowStatus:      &normalStatus,
+		VisibilityList: []store.Visibility{store.Public},
+	}
+	if err := memofilter.ApplySavedSearch(&memoFind, savedSearch); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Invalid filter of saved search").SetInternal(err)
+	}
+	memoList, err := s.Store.ListMemos(ctx, &memoFind)
+	if err != nil {
+		if errors.Is(err, store.ErrMemoFilterNotSupported) {
+			return echo.NewHTTPError(http.StatusNotImplemented, "Unsupported filter of saved search").SetInternal(err)
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
+	}
+
+	baseURL := c.Scheme() + "://" + c.Request().Host
+	rss, err := s.generateRSSFromMemoList(ctx, memoList, baseURL, systemCustomizedProfile)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationXMLCharsetUTF8)
+	return c.String(http.StatusOK, rss)
+}
+
 func (s *APIV1Service) generateRSSFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL string, profile *CustomizedProfile) (string, error) {
 	feed := &feeds.Feed{
 		Title:       profile.Name,
@@ -8,7 +8,11 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/usememos/memos/internal/cron"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/service/backup"
 	"github.com/usememos/memos/store"
 )
 
@@ -42,9 +46,14 @@ const (
 	// SystemSettingMemoDisplayWithUpdatedTsName is the name of memo display with updated ts.
 	SystemSettingMemoDisplayWithUpdatedTsName SystemSettingName = "memo-display-with-updated-ts"
 	// SystemSettingAutoBackupIntervalName is the name of auto backup interval as seconds.
+	//
+	// Deprecated: the backups are scheduled by SystemSettingBackupConfigName,
+	// and setting the interval sets the schedule of the backup config.
 	SystemSettingAutoBackupIntervalName SystemSettingName = "auto-backup-interval"
 	// SystemSettingWebhookUrlName is the url of webhook.
 	SystemSettingWebhookUrlName SystemSettingName = "webhook-url"
+	// SystemSettingBackupConfigName is the name of backup config, whose value is a JSON of storepb.BackupConfig.
+	SystemSettingBackupConfigName SystemSettingName = "BACKUP_CONFIG"
 )
 const systemSettingUnmarshalError = `failed to unmarshal value from system setting "%v"`
 
@@ -184,6 +193,21 @@ func (s *APIV1Service) CreateSystemSetting(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to upsert system setting").SetInternal(err)
 	}
+	if systemSettingUpsert.Name == SystemSettingAutoBackupIntervalName && s.backupRunner != nil {
+		// The deprecated interval is kept working by setting the schedule of the backup config.
+		var interval int
+		if err := json.Unmarshal([]byte(systemSettingUpsert.Value), &interval); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid system setting").SetInternal(err)
+		}
+		if err := backup.SetBackupInterval(ctx, s.Store, interval); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to set backup interval").SetInternal(err)
+		}
+	}
+	if (systemSettingUpsert.Name == SystemSettingBackupConfigName || systemSettingUpsert.Name == SystemSettingAutoBackupIntervalName) && s.backupRunner != nil {
+		if err := s.backupRunner.Reload(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to reload backup config").SetInternal(err)
+		}
+	}
 	return c.JSON(http.StatusOK, convertSystemSettingFromStore(systemSetting))
 }
 
@@ -274,6 +298,19 @@ func (upsert UpsertSystemSettingRequest) Validate() error {
 		if err := json.Unmarshal([]byte(upsert.Value), &value); err != nil {
 			return errors.Errorf(systemSettingUnmarshalError, settingName)
 		}
+	case SystemSettingBackupConfigName:
+		backupConfig := &storepb.BackupConfig{}
+		if err := protojson.Unmarshal([]byte(upsert.Value), backupConfig); err != nil {
+			return errors.Errorf(systemSettingUnmarshalError, settingName)
+		}
+		if backupConfig.MaxKeep < 0 {
+			return errors.New("max keep must be positive")
+		}
+		if backupConfig.Enabled {
+			if _, err := cron.NewSchedule(backupConfig.Cron); err != nil {
+				return errors.Wrap(err, "invalid cron expression")
+			}
+		}
 	case SystemSettingWebhookUrlName:
 		if upsert.Value == "" {
 			return nil
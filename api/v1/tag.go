@@ -4,9 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"regexp"
-	"sort"
-	"strings"
 
 	"github.com/labstack/echo/v4"
 	"golang.org/x/exp/slices"
@@ -27,15 +24,6 @@ type DeleteTagRequest struct {
 	Name string `json:"name"`
 }
 
-func getValidTags(tag string) []string {
-	tags := strings.Split(tag, "/")
-	validTags := make([]string, len(tags))
-	for i := range tags {
-		validTags[i] = strings.Join(tags[:i+1], "/")
-	}
-	return validTags
-}
-
 func (s *APIV1Service) registerTagRoutes(g *echo.Group) {
 	g.GET("/tag", s.GetTagList)
 	g.POST("/tag", s.CreateTag)
@@ -119,7 +107,7 @@ func (s *APIV1Service) CreateTag(c echo.Context) error {
 //	@Produce	json
 //	@Success	200	{object}	map[string]int	"Tag Count Dict"
 //	@Failure	400	{object}	nil			"Missing user id to find tag"
-//	@Failure	500	{object}	nil			"Failed to find tag list"
+//	@Failure	500	{object}	nil			"Failed to find tag count list"
 //	@Security	ApiKeyAuth
 //	@Router		/api/v1/tag_cnt [GET]
 func (s *APIV1Service) GetTagCnt(c echo.Context) error {
@@ -129,30 +117,17 @@ func (s *APIV1Service) GetTagCnt(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Missing user session")
 	}
 	normalRowStatus := store.Normal
-
-	memoFind := &store.FindMemo{
-		CreatorID:     &userID,
-		ContentSearch: []string{"#"},
-		RowStatus:     &normalRowStatus,
-	}
-
-	memoMessageList, err := s.Store.ListMemos(ctx, memoFind)
+	memoTagCounts, err := s.Store.ListMemoTagCounts(ctx, &store.FindMemoTag{
+		CreatorID: &userID,
+		RowStatus: &normalRowStatus,
+	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find tag count list").SetInternal(err)
 	}
 
 	tagCountMap := make(map[string]int)
-	for _, memo := range memoMessageList {
-		curMemoTag := make(map[string]bool)
-		for tag := range createMemoTagMapSet(memo.Content) {
-			valid_tags := getValidTags(tag)
-			for _, valid_tag := range valid_tags {
-				curMemoTag[valid_tag] = true
-			}
-		}
-		for tag := range curMemoTag {
-			tagCountMap[tag]++
-		}
+	for _, memoTagCount := range memoTagCounts {
+		tagCountMap[memoTagCount.Tag] = memoTagCount.MemoCount
 	}
 	return c.JSON(http.StatusOK, tagCountMap)
 }
@@ -201,7 +176,7 @@ func (s *APIV1Service) DeleteTag(c echo.Context) error {
 //	@Produce	json
 //	@Success	200	{object}	[]string	"Tag list"
 //	@Failure	400	{object}	nil			"Missing user session"
-//	@Failure	500	{object}	nil			"Failed to find memo list | Failed to find tag list"
+//	@Failure	500	{object}	nil			"Failed to find tag count list | Failed to find tag list"
 //	@Router		/api/v1/tag/suggestion [GET]
 func (s *APIV1Service) GetTagSuggestion(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -210,15 +185,13 @@ func (s *APIV1Service) GetTagSuggestion(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Missing user session")
 	}
 	normalRowStatus := store.Normal
-	memoFind := &store.FindMemo{
-		CreatorID:     &userID,
-		ContentSearch: []string{"#"},
-		RowStatus:     &normalRowStatus,
-	}
-
-	memoMessageList, err := s.Store.ListMemos(ctx, memoFind)
+	memoTagCounts, err := s.Store.ListMemoTagCounts(ctx, &store.FindMemoTag{
+		CreatorID:       &userID,
+		RowStatus:       &normalRowStatus,
+		ExcludeImplicit: true,
+	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find tag count list").SetInternal(err)
 	}
 
 	list, err := s.Store.ListTags(ctx, &store.FindTag{
@@ -232,19 +205,13 @@ func (s *APIV1Service) GetTagSuggestion(c echo.Context) error {
 		tagNameList = append(tagNameList, tag.Name)
 	}
 
-	tagMapSet := make(map[string]bool)
-	for _, memo := range memoMessageList {
-		for _, tag := range findTagListFromMemoContent(memo.Content) {
-			if !slices.Contains(tagNameList, tag) {
-				tagMapSet[tag] = true
-			}
-		}
-	}
+	// The tag counts are sorted by tag already.
 	tagList := []string{}
-	for tag := range tagMapSet {
-		tagList = append(tagList, tag)
+	for _, memoTagCount := range memoTagCounts {
+		if !slices.Contains(tagNameList, memoTagCount.Tag) {
+			tagList = append(tagList, memoTagCount.Tag)
+		}
 	}
-	sort.Strings(tagList)
 	return c.JSON(http.StatusOK, tagList)
 }
 
@@ -254,29 +221,3 @@ func convertTagFromStore(tag *store.Tag) *Tag {
 		CreatorID: tag.CreatorID,
 	}
 }
-
-var tagRegexp = regexp.MustCompile(`#([^\s#,\.]+)`)
-
-func findTagListFromMemoContent(memoContent string) []string {
-	tagMapSet := createMemoTagMapSet(memoContent)
-
-	tagList := []string{}
-	for tag := range tagMapSet {
-		tagList = append(tagList, tag)
-	}
-	sort.Strings(tagList)
-	return tagList
-}
-
-func createMemoTagMapSet(memoContent string) map[string]bool {
-	codeBlockRegex := regexp.MustCompile("(?s)```.*?```")
-	memoContent = codeBlockRegex.ReplaceAllString(memoContent, "")
-
-	tagMapSet := make(map[string]bool)
-	matches := tagRegexp.FindAllStringSubmatch(memoContent, -1)
-	for _, v := range matches {
-		tagName := v[1]
-		tagMapSet[tagName] = true
-	}
-	return tagMapSet
-}
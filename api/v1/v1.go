@@ -10,14 +10,18 @@ import (
 	"github.com/usememos/memos/api/resource"
 	"github.com/usememos/memos/plugin/telegram"
 	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/server/service/backup"
+	webhookdispatcher "github.com/usememos/memos/server/service/webhook_dispatcher"
 	"github.com/usememos/memos/store"
 )
 
 type APIV1Service struct {
-	Secret      string
-	Profile     *profile.Profile
-	Store       *store.Store
-	telegramBot *telegram.Bot
+	Secret            string
+	Profile           *profile.Profile
+	Store             *store.Store
+	telegramBot       *telegram.Bot
+	webhookDispatcher *webhookdispatcher.WebhookDispatcher
+	backupRunner      *backup.BackupRunner
 }
 
 // @title						memos API
@@ -34,12 +38,14 @@ type APIV1Service struct {
 //
 // @externalDocs.url			https://usememos.com/
 // @externalDocs.description	Find out more about Memos.
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, telegramBot *telegram.Bot) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, telegramBot *telegram.Bot, webhookDispatcher *webhookdispatcher.WebhookDispatcher, backupRunner *backup.BackupRunner) *APIV1Service {
 	return &APIV1Service{
-		Secret:      secret,
-		Profile:     profile,
-		Store:       store,
-		telegramBot: telegramBot,
+		Secret:            secret,
+		Profile:           profile,
+		Store:             store,
+		telegramBot:       telegramBot,
+		webhookDispatcher: webhookDispatcher,
+		backupRunner:      backupRunner,
 	}
 }
 
@@ -78,6 +84,9 @@ func (s *APIV1Service) Register(rootGroup *echo.Group) {
 	s.registerMemoRoutes(apiV1Group)
 	s.registerMemoOrganizerRoutes(apiV1Group)
 	s.registerMemoRelationRoutes(apiV1Group)
+	s.registerMemoRevisionRoutes(apiV1Group)
+	s.registerBackupRoutes(apiV1Group)
+	s.registerExportRoutes(apiV1Group)
 
 	// Register public routes.
 	publicGroup := rootGroup.Group("/o")
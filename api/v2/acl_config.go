@@ -7,6 +7,9 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v2.AuthService/GetAuthStatus":   true,
 	"/memos.api.v2.UserService/GetUser":         true,
 	"/memos.api.v2.MemoService/ListMemos":       true,
+	// The memo graph only traverses the public memos for the anonymous users.
+	"/memos.api.v2.MemoRelationService/GetMemoGraph":      true,
+	"/memos.api.v2.MemoRelationService/ListMemoBacklinks": true,
 }
 
 // isUnauthorizeAllowedMethod returns whether the method is exempted from authentication.
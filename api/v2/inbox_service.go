@@ -0,0 +1,222 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV2Service) ListInboxes(ctx context.Context, request *apiv2pb.ListInboxesRequest) (*apiv2pb.ListInboxesResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.User != "" {
+		username, err := ExtractUsernameFromName(request.User)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid username: %v", err)
+		}
+		// Only the receiver can read the inboxes.
+		if username != user.Username {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	inboxFind := &store.FindInbox{
+		ReceiverID: &user.ID,
+	}
+	if request.PageSize != 0 {
+		offset := int(request.Page * request.PageSize)
+		limit := int(request.PageSize)
+		inboxFind.Offset = &offset
+		inboxFind.Limit = &limit
+	}
+	inboxes, err := s.Store.ListInboxes(ctx, inboxFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
+	}
+
+	unreadStatus := store.UNREAD
+	unreadInboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+		Status:     &unreadStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list unread inboxes: %v", err)
+	}
+
+	response := &apiv2pb.ListInboxesResponse{
+		Inboxes:     []*apiv2pb.Inbox{},
+		UnreadCount: int32(len(unreadInboxes)),
+	}
+	for _, inbox := range inboxes {
+		inboxMessage, err := s.convertInboxFromStore(ctx, inbox)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert inbox: %v", err)
+		}
+		response.Inboxes = append(response.Inboxes, inboxMessage)
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) UpdateInbox(ctx context.Context, request *apiv2pb.UpdateInboxRequest) (*apiv2pb.UpdateInboxResponse, error) {
+	if request.Inbox == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "inbox is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	inbox, err := s.getInboxOwnedByCurrentUser(ctx, request.Inbox.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateInbox{
+		ID:     inbox.ID,
+		Status: inbox.Status,
+	}
+	for _, field := range request.UpdateMask.Paths {
+		if field == "status" {
+			if request.Inbox.Status == apiv2pb.Inbox_STATUS_UNSPECIFIED {
+				return nil, status.Errorf(codes.InvalidArgument, "status is required")
+			}
+			update.Status = convertInboxStatusToStore(request.Inbox.Status)
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported field in update mask: %s", field)
+		}
+	}
+
+	inbox, err = s.Store.UpdateInbox(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update inbox: %v", err)
+	}
+	inboxMessage, err := s.convertInboxFromStore(ctx, inbox)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert inbox: %v", err)
+	}
+	return &apiv2pb.UpdateInboxResponse{
+		Inbox: inboxMessage,
+	}, nil
+}
+
+func (s *APIV2Service) DeleteInbox(ctx context.Context, request *apiv2pb.DeleteInboxRequest) (*apiv2pb.DeleteInboxResponse, error) {
+	inbox, err := s.getInboxOwnedByCurrentUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.DeleteInbox(ctx, &store.DeleteInbox{
+		ID: inbox.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete inbox: %v", err)
+	}
+	return &apiv2pb.DeleteInboxResponse{}, nil
+}
+
+// getInboxOwnedByCurrentUser returns the inbox with the given name, which must be received by the current user.
+func (s *APIV2Service) getInboxOwnedByCurrentUser(ctx context.Context, name string) (*store.Inbox, error) {
+	inboxID, err := ExtractInboxIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid inbox name: %v", err)
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ID: &inboxID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get inbox: %v", err)
+	}
+	if len(inboxes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "inbox not found")
+	}
+	inbox := inboxes[0]
+	if inbox.ReceiverID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return inbox, nil
+}
+
+func (s *APIV2Service) convertInboxFromStore(ctx context.Context, inbox *store.Inbox) (*apiv2pb.Inbox, error) {
+	sender, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &inbox.SenderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sender == nil {
+		return nil, errors.Errorf("sender %d not found", inbox.SenderID)
+	}
+	receiver, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &inbox.ReceiverID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if receiver == nil {
+		return nil, errors.Errorf("receiver %d not found", inbox.ReceiverID)
+	}
+
+	inboxMessage := &apiv2pb.Inbox{
+		Name:       fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID),
+		Sender:     fmt.Sprintf("%s%s", UserNamePrefix, sender.Username),
+		Receiver:   fmt.Sprintf("%s%s", UserNamePrefix, receiver.Username),
+		Status:     convertInboxStatusFromStore(inbox.Status),
+		CreateTime: timestamppb.New(time.Unix(inbox.CreatedTs, 0)),
+	}
+	if inbox.Message != nil {
+		inboxMessage.Type = convertInboxTypeFromStore(inbox.Message.Type)
+		inboxMessage.ActivityId = inbox.Message.ActivityId
+	}
+	return inboxMessage, nil
+}
+
+func convertInboxStatusFromStore(status store.InboxStatus) apiv2pb.Inbox_Status {
+	switch status {
+	case store.UNREAD:
+		return apiv2pb.Inbox_UNREAD
+	case store.ARCHIVED:
+		return apiv2pb.Inbox_ARCHIVED
+	default:
+		return apiv2pb.Inbox_STATUS_UNSPECIFIED
+	}
+}
+
+func convertInboxStatusToStore(status apiv2pb.Inbox_Status) store.InboxStatus {
+	switch status {
+	case apiv2pb.Inbox_ARCHIVED:
+		return store.ARCHIVED
+	default:
+		return store.UNREAD
+	}
+}
+
+func convertInboxTypeFromStore(inboxType storepb.InboxMessage_Type) apiv2pb.Inbox_Type {
+	switch inboxType {
+	case storepb.InboxMessage_TYPE_MEMO_COMMENT:
+		return apiv2pb.Inbox_TYPE_MEMO_COMMENT
+	case storepb.InboxMessage_TYPE_VERSION_UPDATE:
+		return apiv2pb.Inbox_TYPE_VERSION_UPDATE
+	default:
+		return apiv2pb.Inbox_TYPE_UNSPECIFIED
+	}
+}
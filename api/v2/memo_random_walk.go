@@ -0,0 +1,399 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/util"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	defaultRandomWalkSteps = 5
+	maxRandomWalkSteps     = 20
+
+	// The weights of the neighbors of a memo in the walk. A neighbor sums the weights of all its reasons.
+	randomWalkReferenceWeight = 3.0
+	randomWalkSharedTagWeight = 1.5
+	// randomWalkTimeWeight is the weight of a memo created at the same time, which halves after a day.
+	randomWalkTimeWeight = 1.0
+	// randomWalkTimeNeighbors is the number of the memos created before and after a memo which are its neighbors by time.
+	randomWalkTimeNeighbors = 3
+
+	// randomWalkSessionHistory is the number of the recent memos a session avoids.
+	randomWalkSessionHistory = 50
+	randomWalkSessionTTL     = time.Hour
+)
+
+func (s *APIV2Service) RandomWalk(ctx context.Context, request *apiv2pb.RandomWalkRequest) (*apiv2pb.RandomWalkResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	steps := request.Steps
+	if steps == 0 {
+		steps = defaultRandomWalkSteps
+	}
+	if steps < 0 || steps > maxRandomWalkSteps {
+		return nil, status.Errorf(codes.InvalidArgument, "steps must be between 1 and %d", maxRandomWalkSteps)
+	}
+	session := request.Session
+	if session == "" {
+		session = util.GenUUID()
+	}
+
+	graph, err := s.buildRandomWalkGraph(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Id != 0 {
+		if _, ok := graph.createdTs[request.Id]; !ok {
+			return nil, status.Errorf(codes.NotFound, "memo not found")
+		}
+	}
+
+	sessionKey := fmt.Sprintf("%d/%s", user.ID, session)
+	walk := graph.walk(request.Id, int(steps), s.randomWalkSessions.recent(sessionKey), rand.Float64)
+	memoIDList := []int32{}
+	response := &apiv2pb.RandomWalkResponse{
+		Path:    []*apiv2pb.RandomWalkStep{},
+		Session: session,
+	}
+	for _, step := range walk {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID: &step.memoID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			continue
+		}
+		memoIDList = append(memoIDList, memo.ID)
+		response.Path = append(response.Path, &apiv2pb.RandomWalkStep{
+			Memo:        convertMemoFromStore(memo),
+			Reason:      step.reason,
+			Tag:         step.tag,
+			Probability: step.probability,
+		})
+	}
+	s.randomWalkSessions.record(sessionKey, memoIDList)
+	return response, nil
+}
+
+// buildRandomWalkGraph loads the normal memos of the user with their references and tags.
+func (s *APIV2Service) buildRandomWalkGraph(ctx context.Context, userID int32) (*randomWalkGraph, error) {
+	normalStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:      &userID,
+		RowStatus:      &normalStatus,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	graph := newRandomWalkGraph()
+	for _, memo := range memos {
+		graph.addMemo(memo.ID, memo.CreatedTs)
+	}
+
+	memoTags, err := s.Store.ListMemoTags(ctx, &store.FindMemoTag{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeImplicit: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo tags: %v", err)
+	}
+	for _, memoTag := range memoTags {
+		graph.addTag(memoTag.MemoID, memoTag.Tag)
+	}
+
+	referenceType := store.MemoRelationReference
+	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		Type:      &referenceType,
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+	for _, memoRelation := range memoRelations {
+		graph.addReference(memoRelation.MemoID, memoRelation.RelatedMemoID)
+	}
+	graph.sortByCreatedTs()
+	return graph, nil
+}
+
+// randomWalkGraph links the memos of a user by their references, shared tags and creation time.
+type randomWalkGraph struct {
+	createdTs map[int32]int64
+	// memoIDs are sorted by the creation time.
+	memoIDs    []int32
+	positions  map[int32]int
+	references map[int32][]int32
+	tags       map[int32][]string
+	tagMemoIDs map[string][]int32
+}
+
+type randomWalkCandidate struct {
+	memoID          int32
+	referenceWeight float64
+	tagWeight       float64
+	timeWeight      float64
+	// tag is the first shared tag.
+	tag string
+}
+
+type randomWalkStep struct {
+	memoID      int32
+	reason      apiv2pb.RandomWalkStep_Reason
+	tag         string
+	probability float64
+}
+
+func newRandomWalkGraph() *randomWalkGraph {
+	return &randomWalkGraph{
+		createdTs:  map[int32]int64{},
+		positions:  map[int32]int{},
+		references: map[int32][]int32{},
+		tags:       map[int32][]string{},
+		tagMemoIDs: map[string][]int32{},
+	}
+}
+
+func (g *randomWalkGraph) addMemo(memoID int32, createdTs int64) {
+	g.createdTs[memoID] = createdTs
+	g.memoIDs = append(g.memoIDs, memoID)
+}
+
+func (g *randomWalkGraph) addTag(memoID int32, tag string) {
+	if _, ok := g.createdTs[memoID]; !ok {
+		return
+	}
+	g.tags[memoID] = append(g.tags[memoID], tag)
+	g.tagMemoIDs[tag] = append(g.tagMemoIDs[tag], memoID)
+}
+
+// addReference links both memos, as the walk follows the references both ways.
+// The references to the memos outside the graph are ignored.
+func (g *randomWalkGraph) addReference(memoID, relatedMemoID int32) {
+	if memoID == relatedMemoID {
+		return
+	}
+	if _, ok := g.createdTs[memoID]; !ok {
+		return
+	}
+	if _, ok := g.createdTs[relatedMemoID]; !ok {
+		return
+	}
+	g.references[memoID] = append(g.references[memoID], relatedMemoID)
+	g.references[relatedMemoID] = append(g.references[relatedMemoID], memoID)
+}
+
+func (g *randomWalkGraph) sortByCreatedTs() {
+	sort.Slice(g.memoIDs, func(i, j int) bool {
+		if g.createdTs[g.memoIDs[i]] != g.createdTs[g.memoIDs[j]] {
+			return g.createdTs[g.memoIDs[i]] < g.createdTs[g.memoIDs[j]]
+		}
+		return g.memoIDs[i] < g.memoIDs[j]
+	})
+	for i, memoID := range g.memoIDs {
+		g.positions[memoID] = i
+	}
+}
+
+// candidates returns the neighbors of the memo which are not excluded, sorted by id.
+func (g *randomWalkGraph) candidates(memoID int32, excluded map[int32]bool) []*randomWalkCandidate {
+	candidateMap := map[int32]*randomWalkCandidate{}
+	getCandidate := func(id int32) *randomWalkCandidate {
+		if id == memoID || excluded[id] {
+			return nil
+		}
+		candidate, ok := candidateMap[id]
+		if !ok {
+			candidate = &randomWalkCandidate{memoID: id}
+			candidateMap[id] = candidate
+		}
+		return candidate
+	}
+
+	for _, id := range g.references[memoID] {
+		if candidate := getCandidate(id); candidate != nil && candidate.referenceWeight == 0 {
+			candidate.referenceWeight = randomWalkReferenceWeight
+		}
+	}
+	for _, tag := range g.tags[memoID] {
+		for _, id := range g.tagMemoIDs[tag] {
+			if candidate := getCandidate(id); candidate != nil {
+				candidate.tagWeight += randomWalkSharedTagWeight
+				if candidate.tag == "" {
+					candidate.tag = tag
+				}
+			}
+		}
+	}
+	position := g.positions[memoID]
+	for i := position - randomWalkTimeNeighbors; i <= position+randomWalkTimeNeighbors; i++ {
+		if i < 0 || i >= len(g.memoIDs) {
+			continue
+		}
+		id := g.memoIDs[i]
+		if candidate := getCandidate(id); candidate != nil {
+			days := math.Abs(float64(g.createdTs[id]-g.createdTs[memoID])) / (24 * 60 * 60)
+			candidate.timeWeight = randomWalkTimeWeight / (1 + days)
+		}
+	}
+
+	candidates := []*randomWalkCandidate{}
+	for _, candidate := range candidateMap {
+		candidates = append(candidates, candidate)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].memoID < candidates[j].memoID
+	})
+	return candidates
+}
+
+// walk returns a path of up to the number of steps from the start memo, or a random memo if start is 0.
+// The path avoids the recent memos until there is no other memo left. random returns a number in [0, 1).
+func (g *randomWalkGraph) walk(start int32, steps int, recent []int32, random func() float64) []*randomWalkStep {
+	excluded := map[int32]bool{}
+	for _, memoID := range recent {
+		excluded[memoID] = true
+	}
+	visited := map[int32]bool{}
+	path := []*randomWalkStep{}
+	if start != 0 {
+		path = append(path, &randomWalkStep{memoID: start, reason: apiv2pb.RandomWalkStep_START, probability: 1})
+	} else if step := g.jump(excluded, visited, random); step != nil {
+		step.reason = apiv2pb.RandomWalkStep_START
+		path = append(path, step)
+	}
+
+	for len(path) > 0 && len(path) < steps {
+		current := path[len(path)-1].memoID
+		excluded[current], visited[current] = true, true
+		step := g.choose(g.candidates(current, excluded), random)
+		if step == nil {
+			step = g.jump(excluded, visited, random)
+		}
+		if step == nil {
+			break
+		}
+		path = append(path, step)
+	}
+	return path
+}
+
+// choose picks a candidate by its weight, with the reason of its largest weight.
+func (*randomWalkGraph) choose(candidates []*randomWalkCandidate, random func() float64) *randomWalkStep {
+	total := 0.0
+	for _, candidate := range candidates {
+		total += candidate.referenceWeight + candidate.tagWeight + candidate.timeWeight
+	}
+	if total == 0 {
+		return nil
+	}
+
+	target := random() * total
+	chosen := candidates[len(candidates)-1]
+	for _, candidate := range candidates {
+		target -= candidate.referenceWeight + candidate.tagWeight + candidate.timeWeight
+		if target < 0 {
+			chosen = candidate
+			break
+		}
+	}
+	step := &randomWalkStep{
+		memoID:      chosen.memoID,
+		reason:      apiv2pb.RandomWalkStep_REFERENCE,
+		probability: (chosen.referenceWeight + chosen.tagWeight + chosen.timeWeight) / total,
+	}
+	if chosen.tagWeight > chosen.referenceWeight && chosen.tagWeight >= chosen.timeWeight {
+		step.reason, step.tag = apiv2pb.RandomWalkStep_SHARED_TAG, chosen.tag
+	} else if chosen.timeWeight > chosen.referenceWeight && chosen.timeWeight > chosen.tagWeight {
+		step.reason = apiv2pb.RandomWalkStep_TIME_PROXIMITY
+	}
+	return step
+}
+
+// jump picks a random memo which is not excluded, or else which is not visited in the path.
+func (g *randomWalkGraph) jump(excluded, visited map[int32]bool, random func() float64) *randomWalkStep {
+	for _, skipped := range []map[int32]bool{excluded, visited} {
+		memoIDs := []int32{}
+		for _, memoID := range g.memoIDs {
+			if !skipped[memoID] && !visited[memoID] {
+				memoIDs = append(memoIDs, memoID)
+			}
+		}
+		if len(memoIDs) > 0 {
+			return &randomWalkStep{
+				memoID:      memoIDs[int(random()*float64(len(memoIDs)))],
+				reason:      apiv2pb.RandomWalkStep_RANDOM_JUMP,
+				probability: 1 / float64(len(memoIDs)),
+			}
+		}
+	}
+	return nil
+}
+
+// randomWalkSessionCache keeps the recent memos of the walk sessions in memory.
+type randomWalkSessionCache struct {
+	mutex    sync.Mutex
+	sessions map[string]*randomWalkSession
+}
+
+type randomWalkSession struct {
+	memoIDs     []int32
+	updatedTime time.Time
+}
+
+func newRandomWalkSessionCache() *randomWalkSessionCache {
+	return &randomWalkSessionCache{
+		sessions: map[string]*randomWalkSession{},
+	}
+}
+
+func (c *randomWalkSessionCache) recent(key string) []int32 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	session, ok := c.sessions[key]
+	if !ok || time.Since(session.updatedTime) > randomWalkSessionTTL {
+		return nil
+	}
+	return append([]int32{}, session.memoIDs...)
+}
+
+func (c *randomWalkSessionCache) record(key string, memoIDs []int32) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for k, session := range c.sessions {
+		if time.Since(session.updatedTime) > randomWalkSessionTTL {
+			delete(c.sessions, k)
+		}
+	}
+	session, ok := c.sessions[key]
+	if !ok {
+		session = &randomWalkSession{}
+		c.sessions[key] = session
+	}
+	session.memoIDs = append(session.memoIDs, memoIDs...)
+	if len(session.memoIDs) > randomWalkSessionHistory {
+		session.memoIDs = session.memoIDs[len(session.memoIDs)-randomWalkSessionHistory:]
+	}
+	session.updatedTime = time.Now()
+}
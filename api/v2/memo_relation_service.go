@@ -0,0 +1,282 @@
+package v2
+
+import (
+	"context"
+	"sort"
+
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/store"
+)
+
+// maxMemoGraphDepth limits the traversal of GetMemoGraph, which queries the relations of every memo on each hop.
+const maxMemoGraphDepth = 5
+
+func (s *APIV2Service) GetMemoGraph(ctx context.Context, request *apiv2pb.GetMemoGraphRequest) (*apiv2pb.GetMemoGraphResponse, error) {
+	depth := request.Depth
+	if depth == 0 {
+		depth = 1
+	}
+	if depth < 0 || depth > maxMemoGraphDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "depth must be between 1 and %d", maxMemoGraphDepth)
+	}
+	types := []store.MemoRelationType{}
+	for _, relationType := range request.Types {
+		types = append(types, convertMemoRelationTypeToStore(relationType))
+	}
+	for _, customType := range request.CustomTypes {
+		if !store.IsValidMemoRelationTypeName(customType) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid custom type: %s", customType)
+		}
+		types = append(types, store.MemoRelationType(customType))
+	}
+	loader, err := s.newVisibleMemoLoader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := loader.getVisibleMemo(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	// Traverse breadth first, so every memo gets the depth of its shortest path.
+	depthMap := map[int32]int32{memo.ID: 0}
+	edgeMap := map[store.MemoRelation]bool{}
+	frontier := []int32{memo.ID}
+	for hop := int32(1); hop <= depth && len(frontier) > 0; hop++ {
+		next := []int32{}
+		for _, memoID := range frontier {
+			memoID := memoID
+			memoRelations := []*store.MemoRelation{}
+			if request.Direction != apiv2pb.GetMemoGraphRequest_INCOMING {
+				outgoing, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memoID})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+				}
+				memoRelations = append(memoRelations, outgoing...)
+			}
+			if request.Direction != apiv2pb.GetMemoGraphRequest_OUTGOING {
+				incoming, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memoID})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+				}
+				memoRelations = append(memoRelations, incoming...)
+			}
+
+			for _, memoRelation := range memoRelations {
+				if len(types) > 0 && !slices.Contains(types, memoRelation.Type) {
+					continue
+				}
+				neighborID := memoRelation.RelatedMemoID
+				if neighborID == memoID {
+					neighborID = memoRelation.MemoID
+				}
+				// The relations to the invisible memos are skipped, so they never show up in the graph.
+				neighbor, err := loader.getVisibleMemo(ctx, neighborID)
+				if err != nil {
+					return nil, err
+				}
+				if neighbor == nil {
+					continue
+				}
+				edgeMap[*memoRelation] = true
+				if _, ok := depthMap[neighbor.ID]; !ok {
+					depthMap[neighbor.ID] = hop
+					next = append(next, neighbor.ID)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	response := &apiv2pb.GetMemoGraphResponse{
+		Nodes: []*apiv2pb.MemoGraphNode{},
+		Edges: []*apiv2pb.MemoRelation{},
+	}
+	for memoID, memoDepth := range depthMap {
+		memo, err := loader.getVisibleMemo(ctx, memoID)
+		if err != nil {
+			return nil, err
+		}
+		backlinks, err := s.listVisibleBacklinks(ctx, loader, memoID)
+		if err != nil {
+			return nil, err
+		}
+		node := &apiv2pb.MemoGraphNode{
+			Memo:        convertMemoFromStore(memo),
+			Depth:       memoDepth,
+			BacklinkIds: []int32{},
+		}
+		for _, backlink := range backlinks {
+			node.BacklinkIds = append(node.BacklinkIds, backlink.ID)
+		}
+		response.Nodes = append(response.Nodes, node)
+	}
+	sort.Slice(response.Nodes, func(i, j int) bool {
+		if response.Nodes[i].Depth != response.Nodes[j].Depth {
+			return response.Nodes[i].Depth < response.Nodes[j].Depth
+		}
+		return response.Nodes[i].Memo.Id < response.Nodes[j].Memo.Id
+	})
+	for memoRelation := range edgeMap {
+		memoRelation := memoRelation
+		response.Edges = append(response.Edges, convertMemoRelationFromStore(&memoRelation))
+	}
+	sort.Slice(response.Edges, func(i, j int) bool {
+		if response.Edges[i].MemoId != response.Edges[j].MemoId {
+			return response.Edges[i].MemoId < response.Edges[j].MemoId
+		}
+		if response.Edges[i].RelatedMemoId != response.Edges[j].RelatedMemoId {
+			return response.Edges[i].RelatedMemoId < response.Edges[j].RelatedMemoId
+		}
+		if response.Edges[i].Type != response.Edges[j].Type {
+			return response.Edges[i].Type < response.Edges[j].Type
+		}
+		return response.Edges[i].CustomType < response.Edges[j].CustomType
+	})
+	return response, nil
+}
+
+func (s *APIV2Service) ListMemoBacklinks(ctx context.Context, request *apiv2pb.ListMemoBacklinksRequest) (*apiv2pb.ListMemoBacklinksResponse, error) {
+	loader, err := s.newVisibleMemoLoader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := loader.getVisibleMemo(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	backlinks, err := s.listVisibleBacklinks(ctx, loader, memo.ID)
+	if err != nil {
+		return nil, err
+	}
+	response := &apiv2pb.ListMemoBacklinksResponse{
+		Memos: []*apiv2pb.Memo{},
+	}
+	for _, backlink := range backlinks {
+		response.Memos = append(response.Memos, convertMemoFromStore(backlink))
+	}
+	return response, nil
+}
+
+// listVisibleBacklinks returns the visible memos referencing the memo, sorted by id.
+func (s *APIV2Service) listVisibleBacklinks(ctx context.Context, loader *visibleMemoLoader, memoID int32) ([]*store.Memo, error) {
+	referenceType := store.MemoRelationReference
+	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoID: &memoID,
+		Type:          &referenceType,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+
+	backlinks := []*store.Memo{}
+	for _, memoRelation := range memoRelations {
+		memo, err := loader.getVisibleMemo(ctx, memoRelation.MemoID)
+		if err != nil {
+			return nil, err
+		}
+		if memo != nil {
+			backlinks = append(backlinks, memo)
+		}
+	}
+	sort.Slice(backlinks, func(i, j int) bool {
+		return backlinks[i].ID < backlinks[j].ID
+	})
+	return backlinks, nil
+}
+
+// visibleMemoLoader gets the memos visible to the current user, who may be anonymous.
+type visibleMemoLoader struct {
+	store  *store.Store
+	viewer *store.User
+	// memos caches the loaded memos by id, where nil is a missing memo.
+	memos map[int32]*store.Memo
+}
+
+func (s *APIV2Service) newVisibleMemoLoader(ctx context.Context) (*visibleMemoLoader, error) {
+	viewer, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	return &visibleMemoLoader{
+		store:  s.Store,
+		viewer: viewer,
+		memos:  map[int32]*store.Memo{},
+	}, nil
+}
+
+// getVisibleMemo returns the memo if it exists and is visible to the viewer, and nil otherwise.
+func (l *visibleMemoLoader) getVisibleMemo(ctx context.Context, memoID int32) (*store.Memo, error) {
+	memo, ok := l.memos[memoID]
+	if !ok {
+		var err error
+		memo, err = l.store.GetMemo(ctx, &store.FindMemo{
+			ID: &memoID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		l.memos[memoID] = memo
+	}
+	if memo == nil || !canViewMemo(memo, l.viewer) {
+		return nil, nil
+	}
+	return memo, nil
+}
+
+// canViewMemo returns whether the memo is visible to the viewer, which is nil for the anonymous users.
+func canViewMemo(memo *store.Memo, viewer *store.User) bool {
+	switch memo.Visibility {
+	case store.Public:
+		return true
+	case store.Protected:
+		return viewer != nil
+	default:
+		return viewer != nil && memo.CreatorID == viewer.ID
+	}
+}
+
+func convertMemoRelationFromStore(memoRelation *store.MemoRelation) *apiv2pb.MemoRelation {
+	relation := &apiv2pb.MemoRelation{
+		MemoId:        memoRelation.MemoID,
+		RelatedMemoId: memoRelation.RelatedMemoID,
+		Type:          convertMemoRelationTypeFromStore(memoRelation.Type),
+	}
+	if !store.IsBuiltinMemoRelationType(memoRelation.Type) {
+		relation.CustomType = string(memoRelation.Type)
+	}
+	return relation
+}
+
+func convertMemoRelationTypeFromStore(relationType store.MemoRelationType) apiv2pb.MemoRelation_Type {
+	switch relationType {
+	case store.MemoRelationReference:
+		return apiv2pb.MemoRelation_REFERENCE
+	case store.MemoRelationComment:
+		return apiv2pb.MemoRelation_COMMENT
+	default:
+		return apiv2pb.MemoRelation_TYPE_UNSPECIFIED
+	}
+}
+
+func convertMemoRelationTypeToStore(relationType apiv2pb.MemoRelation_Type) store.MemoRelationType {
+	switch relationType {
+	case apiv2pb.MemoRelation_REFERENCE:
+		return store.MemoRelationReference
+	case apiv2pb.MemoRelation_COMMENT:
+		return store.MemoRelationComment
+	default:
+		return ""
+	}
+}
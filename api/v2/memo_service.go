@@ -2,13 +2,17 @@ package v2
 
 import (
 	"context"
+	"time"
 
-	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
-	v1alpha1 "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/usememos/memos/internal/log"
+	"github.com/usememos/memos/internal/memofilter"
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webhook"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	"github.com/usememos/memos/store"
 )
@@ -31,9 +35,14 @@ func (s *APIV2Service) CreateMemo(ctx context.Context, request *apiv2pb.CreateMe
 	if err != nil {
 		return nil, err
 	}
+	unresolvedLinks, err := s.Store.ListUnresolvedMemoLinks(ctx, memo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve memo links: %v", err)
+	}
 
 	response := &apiv2pb.CreateMemoResponse{
-		Memo: convertMemoFromStore(memo),
+		Memo:            convertMemoFromStore(memo),
+		UnresolvedLinks: unresolvedLinks,
 	}
 	return response, nil
 }
@@ -41,29 +50,43 @@ func (s *APIV2Service) CreateMemo(ctx context.Context, request *apiv2pb.CreateMe
 func (s *APIV2Service) ListMemos(ctx context.Context, request *apiv2pb.ListMemosRequest) (*apiv2pb.ListMemosResponse, error) {
 	memoFind := &store.FindMemo{}
 	if request.Filter != "" {
-		filter, err := parseListMemosFilter(request.Filter)
+		filter, err := memofilter.Parse(request.Filter)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
-		if filter.Visibility != nil {
-			memoFind.VisibilityList = []store.Visibility{*filter.Visibility}
-		}
-		if filter.CreatedTsBefore != nil {
-			memoFind.CreatedTsBefore = filter.CreatedTsBefore
-		}
-		if filter.CreatedTsAfter != nil {
-			memoFind.CreatedTsAfter = filter.CreatedTsAfter
-		}
+		memoFind.Filter = filter
 	}
 	user, _ := getCurrentUser(ctx, s.Store)
 	// If the user is not authenticated, only public memos are visible.
 	if user == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
 	}
+	if request.SavedSearch != "" {
+		// Saved searches are private to their creators.
+		if user == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		savedSearch, err := s.Store.GetSavedSearch(ctx, &store.FindSavedSearch{
+			CreatorID: &user.ID,
+			Name:      &request.SavedSearch,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get saved search: %v", err)
+		}
+		if savedSearch == nil {
+			return nil, status.Errorf(codes.NotFound, "saved search not found")
+		}
+		if err := memofilter.ApplySavedSearch(memoFind, savedSearch); err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid filter of saved search: %v", err)
+		}
+	}
 
 	if request.CreatorId != nil {
 		memoFind.CreatorID = request.CreatorId
 	}
+	if request.Search != "" {
+		memoFind.SearchQuery = &request.Search
+	}
 
 	// Remove the private memos from the list if the user is not the creator.
 	if user != nil && request.CreatorId != nil && *request.CreatorId != user.ID {
@@ -84,7 +107,13 @@ func (s *APIV2Service) ListMemos(ctx context.Context, request *apiv2pb.ListMemos
 	}
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, store.ErrMemoFilterNotSupported) {
+			return nil, status.Errorf(codes.Unimplemented, "unsupported filter: %v", err)
+		}
+		if errors.Is(err, store.ErrMemoSearchNotSupported) {
+			return nil, status.Errorf(codes.Unimplemented, "unsupported search: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
 	memoMessages := make([]*apiv2pb.Memo, len(memos))
@@ -180,63 +209,198 @@ func (s *APIV2Service) ListMemoComments(ctx context.Context, request *apiv2pb.Li
 	return response, nil
 }
 
-// ListMemosFilterCELAttributes are the CEL attributes for ListMemosFilter.
-var ListMemosFilterCELAttributes = []cel.EnvOption{
-	cel.Variable("visibility", cel.StringType),
-	cel.Variable("created_ts_before", cel.IntType),
-	cel.Variable("created_ts_after", cel.IntType),
+func (s *APIV2Service) ListMemoRevisions(ctx context.Context, request *apiv2pb.ListMemoRevisionsRequest) (*apiv2pb.ListMemoRevisionsResponse, error) {
+	memo, err := s.getMemoOwnedByCurrentUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	memoRevisionFind := &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	}
+	if request.PageSize != 0 {
+		offset := int(request.Page * request.PageSize)
+		limit := int(request.PageSize)
+		memoRevisionFind.Offset = &offset
+		memoRevisionFind.Limit = &limit
+	}
+	memoRevisions, err := s.Store.ListMemoRevisions(ctx, memoRevisionFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+
+	response := &apiv2pb.ListMemoRevisionsResponse{}
+	for _, memoRevision := range memoRevisions {
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(memoRevision))
+	}
+	return response, nil
 }
 
-type ListMemosFilter struct {
-	Visibility      *store.Visibility
-	CreatedTsBefore *int64
-	CreatedTsAfter  *int64
+func (s *APIV2Service) GetMemoRevision(ctx context.Context, request *apiv2pb.GetMemoRevisionRequest) (*apiv2pb.GetMemoRevisionResponse, error) {
+	memo, err := s.getMemoOwnedByCurrentUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	memoRevision, err := s.getMemoRevision(ctx, memo.ID, request.RevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv2pb.GetMemoRevisionResponse{
+		Revision: convertMemoRevisionFromStore(memoRevision),
+	}, nil
 }
 
-func parseListMemosFilter(expression string) (*ListMemosFilter, error) {
-	e, err := cel.NewEnv(ListMemosFilterCELAttributes...)
+func (s *APIV2Service) DiffMemoRevision(ctx context.Context, request *apiv2pb.DiffMemoRevisionRequest) (*apiv2pb.DiffMemoRevisionResponse, error) {
+	memo, err := s.getMemoOwnedByCurrentUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	memoRevision, err := s.getMemoRevision(ctx, memo.ID, request.RevisionId)
 	if err != nil {
 		return nil, err
 	}
-	ast, issues := e.Compile(expression)
-	if issues != nil {
-		return nil, errors.Errorf("found issue %v", issues)
+	compareToContent := memo.Content
+	if request.CompareToRevisionId != nil {
+		compareToRevision, err := s.getMemoRevision(ctx, memo.ID, *request.CompareToRevisionId)
+		if err != nil {
+			return nil, err
+		}
+		compareToContent = compareToRevision.Content
+	}
+
+	response := &apiv2pb.DiffMemoRevisionResponse{}
+	for _, line := range util.DiffLines(memoRevision.Content, compareToContent) {
+		response.Lines = append(response.Lines, &apiv2pb.MemoRevisionDiffLine{
+			Operation: convertDiffOperationFromUtil(line.Operation),
+			Content:   line.Content,
+		})
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) RestoreMemoRevision(ctx context.Context, request *apiv2pb.RestoreMemoRevisionRequest) (*apiv2pb.RestoreMemoRevisionResponse, error) {
+	memo, err := s.getMemoOwnedByCurrentUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
 	}
-	filter := &ListMemosFilter{}
-	expr, err := cel.AstToParsedExpr(ast)
+	memoRevision, err := s.getMemoRevision(ctx, memo.ID, request.RevisionId)
 	if err != nil {
 		return nil, err
 	}
-	callExpr := expr.GetExpr().GetCallExpr()
-	findField(callExpr, filter)
-	return filter, nil
+
+	updatedTs := time.Now().Unix()
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		UpdatedTs: &updatedTs,
+		Content:   &memoRevision.Content,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore memo revision: %v", err)
+	}
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if err := s.dispatchMemoUpdatedWebhook(ctx, memo); err != nil {
+		log.Warn("Failed to dispatch memo updated webhook", zap.Error(err))
+	}
+
+	return &apiv2pb.RestoreMemoRevisionResponse{
+		Memo: convertMemoFromStore(memo),
+	}, nil
 }
 
-func findField(callExpr *v1alpha1.Expr_Call, filter *ListMemosFilter) {
-	if len(callExpr.Args) == 2 {
-		idExpr := callExpr.Args[0].GetIdentExpr()
-		if idExpr != nil {
-			if idExpr.Name == "visibility" {
-				visibility := store.Visibility(callExpr.Args[1].GetConstExpr().GetStringValue())
-				filter.Visibility = &visibility
-			}
-			if idExpr.Name == "created_ts_before" {
-				createdTsBefore := callExpr.Args[1].GetConstExpr().GetInt64Value()
-				filter.CreatedTsBefore = &createdTsBefore
-			}
-			if idExpr.Name == "created_ts_after" {
-				createdTsAfter := callExpr.Args[1].GetConstExpr().GetInt64Value()
-				filter.CreatedTsAfter = &createdTsAfter
-			}
-			return
-		}
+// dispatchMemoUpdatedWebhook dispatches the webhooks of the memo creator with the same payload as the v1 API.
+func (s *APIV2Service) dispatchMemoUpdatedWebhook(ctx context.Context, memo *store.Memo) error {
+	payload := &webhook.WebhookPayload{
+		ActivityType: "memos.memo.updated",
+		CreatorID:    memo.CreatorID,
+		CreatedTs:    time.Now().Unix(),
+		Memo: &webhook.Memo{
+			ID:           memo.ID,
+			CreatorID:    memo.CreatorID,
+			CreatedTs:    memo.CreatedTs,
+			UpdatedTs:    memo.UpdatedTs,
+			Content:      memo.Content,
+			Visibility:   memo.Visibility.String(),
+			Pinned:       memo.Pinned,
+			ResourceList: []*webhook.Resource{},
+			RelationList: []*webhook.MemoRelation{},
+		},
+	}
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list resources")
 	}
-	for _, arg := range callExpr.Args {
-		callExpr := arg.GetCallExpr()
-		if callExpr != nil {
-			findField(callExpr, filter)
+	for _, resource := range resources {
+		payload.Memo.ResourceList = append(payload.Memo.ResourceList, &webhook.Resource{
+			ID:           resource.ID,
+			CreatorID:    resource.CreatorID,
+			CreatedTs:    resource.CreatedTs,
+			UpdatedTs:    resource.UpdatedTs,
+			Filename:     resource.Filename,
+			InternalPath: resource.InternalPath,
+			ExternalLink: resource.ExternalLink,
+			Type:         resource.Type,
+			Size:         resource.Size,
+		})
+	}
+	for _, find := range []*store.FindMemoRelation{{MemoID: &memo.ID}, {RelatedMemoID: &memo.ID}} {
+		memoRelations, err := s.Store.ListMemoRelations(ctx, find)
+		if err != nil {
+			return errors.Wrap(err, "failed to list memo relations")
 		}
+		for _, memoRelation := range memoRelations {
+			payload.Memo.RelationList = append(payload.Memo.RelationList, &webhook.MemoRelation{
+				MemoID:        memoRelation.MemoID,
+				RelatedMemoID: memoRelation.RelatedMemoID,
+				Type:          string(memoRelation.Type),
+			})
+		}
+	}
+	return s.webhookDispatcher.DispatchMemoEvent(ctx, payload)
+}
+
+// getMemoOwnedByCurrentUser returns the memo with the given id if it is owned by the current user.
+func (s *APIV2Service) getMemoOwnedByCurrentUser(ctx context.Context, id int32) (*store.Memo, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	return memo, nil
+}
+
+func (s *APIV2Service) getMemoRevision(ctx context.Context, memoID, revisionID int32) (*store.MemoRevision, error) {
+	memoRevision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memoID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if memoRevision == nil {
+		return nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+	return memoRevision, nil
 }
 
 func convertMemoFromStore(memo *store.Memo) *apiv2pb.Memo {
@@ -249,6 +413,30 @@ func convertMemoFromStore(memo *store.Memo) *apiv2pb.Memo {
 		Content:    memo.Content,
 		Visibility: convertVisibilityFromStore(memo.Visibility),
 		Pinned:     memo.Pinned,
+		Snippet:    memo.Snippet,
+	}
+}
+
+func convertMemoRevisionFromStore(memoRevision *store.MemoRevision) *apiv2pb.MemoRevision {
+	return &apiv2pb.MemoRevision{
+		Id:        memoRevision.ID,
+		MemoId:    memoRevision.MemoID,
+		CreatorId: memoRevision.CreatorID,
+		CreatedTs: memoRevision.CreatedTs,
+		Content:   memoRevision.Content,
+	}
+}
+
+func convertDiffOperationFromUtil(operation util.DiffOperation) apiv2pb.MemoRevisionDiffLine_Operation {
+	switch operation {
+	case util.DiffEqual:
+		return apiv2pb.MemoRevisionDiffLine_EQUAL
+	case util.DiffInsert:
+		return apiv2pb.MemoRevisionDiffLine_INSERT
+	case util.DiffDelete:
+		return apiv2pb.MemoRevisionDiffLine_DELETE
+	default:
+		return apiv2pb.MemoRevisionDiffLine_OPERATION_UNSPECIFIED
 	}
 }
 
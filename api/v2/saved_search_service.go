@@ -0,0 +1,250 @@
+package v2
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/memofilter"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// savedSearchNameMatcher matches the names of the saved searches, which are used in the urls of the views.
+var savedSearchNameMatcher = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+func (s *APIV2Service) CreateSavedSearch(ctx context.Context, request *apiv2pb.CreateSavedSearchRequest) (*apiv2pb.CreateSavedSearchResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if err := validateSavedSearch(request.Name, request.Filter, request.PinnedPosition); err != nil {
+		return nil, err
+	}
+	if err := s.checkSavedSearchNameAvailable(ctx, user.ID, request.Name); err != nil {
+		return nil, err
+	}
+
+	savedSearch, err := s.Store.CreateSavedSearch(ctx, &storepb.SavedSearch{
+		CreatorId:      user.ID,
+		Name:           request.Name,
+		Filter:         request.Filter,
+		Order:          convertSavedSearchOrderToStore(request.Order),
+		PinnedPosition: request.PinnedPosition,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create saved search: %v", err)
+	}
+	return &apiv2pb.CreateSavedSearchResponse{
+		SavedSearch: convertSavedSearchFromStore(savedSearch),
+	}, nil
+}
+
+func (s *APIV2Service) GetSavedSearch(ctx context.Context, request *apiv2pb.GetSavedSearchRequest) (*apiv2pb.GetSavedSearchResponse, error) {
+	savedSearch, err := s.getSavedSearchOwnedByCurrentUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2pb.GetSavedSearchResponse{
+		SavedSearch: convertSavedSearchFromStore(savedSearch),
+	}, nil
+}
+
+func (s *APIV2Service) ListSavedSearches(ctx context.Context, _ *apiv2pb.ListSavedSearchesRequest) (*apiv2pb.ListSavedSearchesResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	savedSearches, err := s.Store.ListSavedSearches(ctx, &store.FindSavedSearch{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list saved searches: %v", err)
+	}
+
+	response := &apiv2pb.ListSavedSearchesResponse{
+		SavedSearches: []*apiv2pb.SavedSearch{},
+	}
+	for _, savedSearch := range savedSearches {
+		response.SavedSearches = append(response.SavedSearches, convertSavedSearchFromStore(savedSearch))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) UpdateSavedSearch(ctx context.Context, request *apiv2pb.UpdateSavedSearchRequest) (*apiv2pb.UpdateSavedSearchResponse, error) {
+	if request.SavedSearch == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "saved search is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	savedSearch, err := s.getSavedSearchOwnedByCurrentUser(ctx, request.SavedSearch.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	currentTs := time.Now().Unix()
+	update := &store.UpdateSavedSearch{
+		ID:        savedSearch.Id,
+		UpdatedTs: &currentTs,
+	}
+	for _, field := range request.UpdateMask.Paths {
+		if field == "name" {
+			if !savedSearchNameMatcher.MatchString(request.SavedSearch.Name) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid name: %s", request.SavedSearch.Name)
+			}
+			if request.SavedSearch.Name != savedSearch.Name {
+				if err := s.checkSavedSearchNameAvailable(ctx, savedSearch.CreatorId, request.SavedSearch.Name); err != nil {
+					return nil, err
+				}
+			}
+			update.Name = &request.SavedSearch.Name
+		} else if field == "filter" {
+			if err := validateSavedSearchFilter(request.SavedSearch.Filter); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+			}
+			update.Filter = &request.SavedSearch.Filter
+		} else if field == "order" {
+			order := convertSavedSearchOrderToStore(request.SavedSearch.Order)
+			update.Order = &order
+		} else if field == "pinned_position" {
+			if request.SavedSearch.PinnedPosition < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "pinned position must not be negative")
+			}
+			update.PinnedPosition = &request.SavedSearch.PinnedPosition
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported field in update mask: %s", field)
+		}
+	}
+
+	savedSearch, err = s.Store.UpdateSavedSearch(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update saved search: %v", err)
+	}
+	return &apiv2pb.UpdateSavedSearchResponse{
+		SavedSearch: convertSavedSearchFromStore(savedSearch),
+	}, nil
+}
+
+func (s *APIV2Service) DeleteSavedSearch(ctx context.Context, request *apiv2pb.DeleteSavedSearchRequest) (*apiv2pb.DeleteSavedSearchResponse, error) {
+	savedSearch, err := s.getSavedSearchOwnedByCurrentUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.DeleteSavedSearch(ctx, &store.DeleteSavedSearch{
+		ID: savedSearch.Id,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete saved search: %v", err)
+	}
+	return &apiv2pb.DeleteSavedSearchResponse{}, nil
+}
+
+// getSavedSearchOwnedByCurrentUser returns the saved search with the given id, which must be created by the current user.
+func (s *APIV2Service) getSavedSearchOwnedByCurrentUser(ctx context.Context, id int32) (*storepb.SavedSearch, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	savedSearch, err := s.Store.GetSavedSearch(ctx, &store.FindSavedSearch{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get saved search: %v", err)
+	}
+	if savedSearch == nil {
+		return nil, status.Errorf(codes.NotFound, "saved search not found")
+	}
+	if savedSearch.CreatorId != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return savedSearch, nil
+}
+
+func (s *APIV2Service) checkSavedSearchNameAvailable(ctx context.Context, creatorID int32, name string) error {
+	savedSearch, err := s.Store.GetSavedSearch(ctx, &store.FindSavedSearch{
+		CreatorID: &creatorID,
+		Name:      &name,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get saved search: %v", err)
+	}
+	if savedSearch != nil {
+		return status.Errorf(codes.AlreadyExists, "saved search %s already exists", name)
+	}
+	return nil
+}
+
+func validateSavedSearch(name, filter string, pinnedPosition int32) error {
+	if !savedSearchNameMatcher.MatchString(name) {
+		return status.Errorf(codes.InvalidArgument, "invalid name: %s", name)
+	}
+	if err := validateSavedSearchFilter(filter); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	if pinnedPosition < 0 {
+		return status.Errorf(codes.InvalidArgument, "pinned position must not be negative")
+	}
+	return nil
+}
+
+// validateSavedSearchFilter validates the filter of a saved search, where an empty filter matches all the memos.
+func validateSavedSearchFilter(filter string) error {
+	if filter == "" {
+		return nil
+	}
+	_, err := memofilter.Parse(filter)
+	return err
+}
+
+func convertSavedSearchFromStore(savedSearch *storepb.SavedSearch) *apiv2pb.SavedSearch {
+	return &apiv2pb.SavedSearch{
+		Id:             savedSearch.Id,
+		CreatorId:      savedSearch.CreatorId,
+		CreatedTime:    timestamppb.New(time.Unix(savedSearch.CreatedTs, 0)),
+		UpdatedTime:    timestamppb.New(time.Unix(savedSearch.UpdatedTs, 0)),
+		Name:           savedSearch.Name,
+		Filter:         savedSearch.Filter,
+		Order:          convertSavedSearchOrderFromStore(savedSearch.Order),
+		PinnedPosition: savedSearch.PinnedPosition,
+	}
+}
+
+func convertSavedSearchOrderFromStore(order storepb.SavedSearch_Order) apiv2pb.SavedSearch_Order {
+	switch order {
+	case storepb.SavedSearch_CREATED_TS_DESC:
+		return apiv2pb.SavedSearch_CREATED_TIME_DESC
+	case storepb.SavedSearch_UPDATED_TS_DESC:
+		return apiv2pb.SavedSearch_UPDATED_TIME_DESC
+	default:
+		return apiv2pb.SavedSearch_ORDER_UNSPECIFIED
+	}
+}
+
+func convertSavedSearchOrderToStore(order apiv2pb.SavedSearch_Order) storepb.SavedSearch_Order {
+	switch order {
+	case apiv2pb.SavedSearch_CREATED_TIME_DESC:
+		return storepb.SavedSearch_CREATED_TS_DESC
+	case apiv2pb.SavedSearch_UPDATED_TIME_DESC:
+		return storepb.SavedSearch_UPDATED_TS_DESC
+	default:
+		return storepb.SavedSearch_ORDER_UNSPECIFIED
+	}
+}
@@ -3,15 +3,17 @@ package v2
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -20,14 +22,65 @@ func (s *APIV2Service) UpsertTag(ctx context.Context, request *apiv2pb.UpsertTag
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if !store.IsValidTag(request.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %s", request.Name)
+	}
 
-	tag, err := s.Store.UpsertTag(ctx, &store.Tag{
+	upsert := &store.Tag{
 		Name:      request.Name,
 		CreatorID: user.ID,
-	})
+	}
+	if request.UpdateMask != nil && len(request.UpdateMask.Paths) > 0 {
+		if request.Tag == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+		}
+		tag, err := s.Store.GetTag(ctx, &store.FindTag{
+			CreatorID: user.ID,
+			Name:      &request.Name,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get tag: %v", err)
+		}
+		upsert.Payload, upsert.Aliases = &storepb.TagPayload{}, []string{}
+		if tag != nil {
+			upsert.Payload, upsert.Aliases = tag.Payload, tag.Aliases
+		}
+		for _, field := range request.UpdateMask.Paths {
+			if field == "color" {
+				upsert.Payload.Color = request.Tag.Color
+			} else if field == "icon" {
+				upsert.Payload.Icon = request.Tag.Icon
+			} else if field == "description" {
+				upsert.Payload.Description = request.Tag.Description
+			} else if field == "sort_weight" {
+				upsert.Payload.SortWeight = request.Tag.SortWeight
+			} else if field == "favorite" {
+				upsert.Payload.Favorite = request.Tag.Favorite
+			} else if field == "aliases" {
+				if err := s.validateTagAliases(ctx, user.ID, request.Name, request.Tag.Aliases); err != nil {
+					return nil, err
+				}
+				upsert.Aliases = request.Tag.Aliases
+			} else {
+				return nil, status.Errorf(codes.InvalidArgument, "unsupported field in update mask: %s", field)
+			}
+		}
+	}
+
+	tag, err := s.Store.UpsertTag(ctx, upsert)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert tag: %v", err)
 	}
+	tag, err = s.Store.GetTag(ctx, &store.FindTag{
+		CreatorID: user.ID,
+		Name:      &tag.Name,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag: %v", err)
+	}
 
 	t, err := s.convertTagFromStore(ctx, tag)
 	if err != nil {
@@ -38,6 +91,42 @@ func (s *APIV2Service) UpsertTag(ctx context.Context, request *apiv2pb.UpsertTag
 	}, nil
 }
 
+// validateTagAliases checks that the aliases are tags other than the existing tags and the aliases of the other tags.
+func (s *APIV2Service) validateTagAliases(ctx context.Context, creatorID int32, name string, aliases []string) error {
+	tags, err := s.Store.ListTags(ctx, &store.FindTag{
+		CreatorID: creatorID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	usedNames := make(map[string]string)
+	for _, tag := range tags {
+		usedNames[tag.Name] = tag.Name
+		if tag.Name == name {
+			continue
+		}
+		for _, alias := range tag.Aliases {
+			usedNames[alias] = tag.Name
+		}
+	}
+	usedNames[name] = name
+
+	aliasSet := make(map[string]bool)
+	for _, alias := range aliases {
+		if !store.IsValidTag(alias) {
+			return status.Errorf(codes.InvalidArgument, "invalid alias: %s", alias)
+		}
+		if aliasSet[alias] {
+			return status.Errorf(codes.InvalidArgument, "duplicate alias: %s", alias)
+		}
+		aliasSet[alias] = true
+		if tag, ok := usedNames[alias]; ok {
+			return status.Errorf(codes.AlreadyExists, "alias %s is used by tag %s", alias, tag)
+		}
+	}
+	return nil
+}
+
 func (s *APIV2Service) ListTags(ctx context.Context, request *apiv2pb.ListTagsRequest) (*apiv2pb.ListTagsResponse, error) {
 	username, err := ExtractUsernameFromName(request.User)
 	if err != nil {
@@ -59,6 +148,9 @@ func (s *APIV2Service) ListTags(ctx context.Context, request *apiv2pb.ListTagsRe
 		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
 	}
 
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].Payload.SortWeight > tags[j].Payload.SortWeight
+	})
 	response := &apiv2pb.ListTagsResponse{}
 	for _, tag := range tags {
 		t, err := s.convertTagFromStore(ctx, tag)
@@ -71,65 +163,117 @@ func (s *APIV2Service) ListTags(ctx context.Context, request *apiv2pb.ListTagsRe
 }
 
 func (s *APIV2Service) RenameTag(ctx context.Context, request *apiv2pb.RenameTagRequest) (*apiv2pb.RenameTagResponse, error) {
-	username, err := ExtractUsernameFromName(request.User)
+	user, err := s.getTagCreatorOfCurrentUser(ctx, request.User)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid username: %v", err)
+		return nil, err
 	}
-	user, err := s.Store.GetUser(ctx, &store.FindUser{
-		Username: &username,
+	// An empty target removes the tag from the memos, which is what DeleteTag is for.
+	if request.NewName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "new name is required")
+	}
+
+	memoIDs, err := s.rewriteTag(ctx, &store.RewriteTag{
+		CreatorID:      user.ID,
+		SourceTags:     []string{request.OldName},
+		TargetTag:      request.NewName,
+		IncludeSubTags: request.IncludeSubTags,
+		DryRun:         request.DryRun,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		return nil, err
 	}
-	if user == nil {
-		return nil, status.Errorf(codes.NotFound, "user not found")
+	tagMessage, err := s.convertTagFromStore(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      request.NewName,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert tag: %v", err)
 	}
+	return &apiv2pb.RenameTagResponse{
+		Tag:     tagMessage,
+		MemoIds: memoIDs,
+	}, nil
+}
 
-	// Find all related memos.
-	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
-		CreatorID:     &user.ID,
-		ContentSearch: []string{fmt.Sprintf("#%s", request.OldName)},
-	})
+func (s *APIV2Service) MergeTags(ctx context.Context, request *apiv2pb.MergeTagsRequest) (*apiv2pb.MergeTagsResponse, error) {
+	user, err := s.getTagCreatorOfCurrentUser(ctx, request.User)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		return nil, err
 	}
-	// Replace tag name in memo content.
-	for _, memo := range memos {
-		content := memo.Content
-		var _tmpTagReg = regexp.MustCompile(`#` + request.OldName)
-		content = _tmpTagReg.ReplaceAllString(content, fmt.Sprintf("#%s", request.NewName))
-		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
-			ID:      memo.ID,
-			Content: &content,
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
-		}
+	if request.TargetName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "target name is required")
 	}
 
-	// Delete old tag and create new tag.
-	if err := s.Store.DeleteTag(ctx, &store.DeleteTag{
-		CreatorID: user.ID,
-		Name:      request.OldName,
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+	memoIDs, err := s.rewriteTag(ctx, &store.RewriteTag{
+		CreatorID:      user.ID,
+		SourceTags:     request.SourceNames,
+		TargetTag:      request.TargetName,
+		IncludeSubTags: request.IncludeSubTags,
+		DryRun:         request.DryRun,
+	})
+	if err != nil {
+		return nil, err
 	}
-	tag, err := s.Store.UpsertTag(ctx, &store.Tag{
+	tagMessage, err := s.convertTagFromStore(ctx, &store.Tag{
 		CreatorID: user.ID,
-		Name:      request.NewName,
+		Name:      request.TargetName,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upsert tag: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to convert tag: %v", err)
 	}
+	return &apiv2pb.MergeTagsResponse{
+		Tag:     tagMessage,
+		MemoIds: memoIDs,
+	}, nil
+}
 
-	tagMessage, err := s.convertTagFromStore(ctx, tag)
+func (s *APIV2Service) DeleteTag(ctx context.Context, request *apiv2pb.DeleteTagRequest) (*apiv2pb.DeleteTagResponse, error) {
+	if request.Tag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	user, err := s.getTagCreatorOfCurrentUser(ctx, request.Tag.Creator)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert tag: %v", err)
+		return nil, err
+	}
+
+	if request.RemoveFromMemos {
+		// Removing the tag from the memos also deletes it, and its sub tags if included.
+		memoIDs, err := s.rewriteTag(ctx, &store.RewriteTag{
+			CreatorID:      user.ID,
+			SourceTags:     []string{request.Tag.Name},
+			IncludeSubTags: request.IncludeSubTags,
+			DryRun:         request.DryRun,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &apiv2pb.DeleteTagResponse{
+			MemoIds: memoIDs,
+		}, nil
 	}
-	return &apiv2pb.RenameTagResponse{Tag: tagMessage}, nil
+	if request.DryRun {
+		return &apiv2pb.DeleteTagResponse{}, nil
+	}
+	if err := s.Store.DeleteTag(ctx, &store.DeleteTag{
+		Name:      request.Tag.Name,
+		CreatorID: user.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+	}
+
+	return &apiv2pb.DeleteTagResponse{}, nil
 }
 
-func (s *APIV2Service) DeleteTag(ctx context.Context, request *apiv2pb.DeleteTagRequest) (*apiv2pb.DeleteTagResponse, error) {
-	username, err := ExtractUsernameFromName(request.Tag.Creator)
+// getTagCreatorOfCurrentUser returns the creator of the tags by name, who must be the current user.
+func (s *APIV2Service) getTagCreatorOfCurrentUser(ctx context.Context, name string) (*store.User, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	username, err := ExtractUsernameFromName(name)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid username: %v", err)
 	}
@@ -142,14 +286,32 @@ func (s *APIV2Service) DeleteTag(ctx context.Context, request *apiv2pb.DeleteTag
 	if user == nil {
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
-	if err := s.Store.DeleteTag(ctx, &store.DeleteTag{
-		Name:      request.Tag.Name,
-		CreatorID: user.ID,
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+	if user.ID != currentUser.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	return user, nil
+}
 
-	return &apiv2pb.DeleteTagResponse{}, nil
+func (s *APIV2Service) rewriteTag(ctx context.Context, rewrite *store.RewriteTag) ([]int32, error) {
+	if len(rewrite.SourceTags) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "source tags are required")
+	}
+	for _, tag := range rewrite.SourceTags {
+		if !store.IsValidTag(tag) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %s", tag)
+		}
+	}
+	if rewrite.TargetTag != "" && !store.IsValidTag(rewrite.TargetTag) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %s", rewrite.TargetTag)
+	}
+	memoIDs, err := s.Store.RewriteTag(ctx, rewrite)
+	if err != nil {
+		if errors.Is(err, store.ErrTagRewriteConflict) {
+			return nil, status.Errorf(codes.Aborted, "memos changed during the rewrite, please try again: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to rewrite tag: %v", err)
+	}
+	return memoIDs, nil
 }
 
 func (s *APIV2Service) GetTagSuggestions(ctx context.Context, request *apiv2pb.GetTagSuggestionsRequest) (*apiv2pb.GetTagSuggestionsResponse, error) {
@@ -167,14 +329,13 @@ func (s *APIV2Service) GetTagSuggestions(ctx context.Context, request *apiv2pb.G
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
 	normalRowStatus := store.Normal
-	memoFind := &store.FindMemo{
-		CreatorID:     &user.ID,
-		ContentSearch: []string{"#"},
-		RowStatus:     &normalRowStatus,
-	}
-	memoList, err := s.Store.ListMemos(ctx, memoFind)
+	memoTagCounts, err := s.Store.ListMemoTagCounts(ctx, &store.FindMemoTag{
+		CreatorID:       &user.ID,
+		RowStatus:       &normalRowStatus,
+		ExcludeImplicit: true,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list memo tag counts: %v", err)
 	}
 
 	tagList, err := s.Store.ListTags(ctx, &store.FindTag{
@@ -184,29 +345,95 @@ func (s *APIV2Service) GetTagSuggestions(ctx context.Context, request *apiv2pb.G
 		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
 	}
 
+	// The tags used by their aliases are saved already.
 	tagNameList := []string{}
 	for _, tag := range tagList {
 		tagNameList = append(tagNameList, tag.Name)
+		tagNameList = append(tagNameList, tag.Aliases...)
 	}
-	tagMapSet := make(map[string]bool)
-	for _, memo := range memoList {
-		for _, tag := range findTagListFromMemoContent(memo.Content) {
-			if !slices.Contains(tagNameList, tag) {
-				tagMapSet[tag] = true
-			}
-		}
-	}
+	// The tag counts are sorted by tag already.
 	suggestions := []string{}
-	for tag := range tagMapSet {
-		suggestions = append(suggestions, tag)
+	for _, memoTagCount := range memoTagCounts {
+		if !slices.Contains(tagNameList, memoTagCount.Tag) {
+			suggestions = append(suggestions, memoTagCount.Tag)
+		}
 	}
-	sort.Strings(suggestions)
 
 	return &apiv2pb.GetTagSuggestionsResponse{
 		Tags: suggestions,
 	}, nil
 }
 
+func (s *APIV2Service) GetTagStats(ctx context.Context, request *apiv2pb.GetTagStatsRequest) (*apiv2pb.GetTagStatsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.Tag != "" && !store.IsValidTag(request.Tag) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %s", request.Tag)
+	}
+
+	tagStats, err := s.Store.GetTagStats(ctx, &store.FindTagStats{
+		CreatorID: user.ID,
+		Exact:     request.Exact,
+		RootTag:   request.Tag,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag stats: %v", err)
+	}
+
+	response := &apiv2pb.GetTagStatsResponse{
+		Usages:        []*apiv2pb.TagUsage{},
+		CoOccurrences: convertTagCoOccurrencesFromStore(tagStats.CoOccurrences),
+		Neighbors:     convertTagCoOccurrencesFromStore(tagStats.Neighbors),
+	}
+	for _, usage := range tagStats.Usages {
+		tagUsage := &apiv2pb.TagUsage{
+			Tag:           usage.Tag,
+			MemoCount:     int32(usage.MemoCount),
+			FirstUsedTime: timestamppb.New(time.Unix(usage.FirstUsedTs, 0)),
+			LastUsedTime:  timestamppb.New(time.Unix(usage.LastUsedTs, 0)),
+		}
+		for _, monthlyUsage := range usage.MonthlyUsages {
+			tagUsage.MonthlyUsages = append(tagUsage.MonthlyUsages, &apiv2pb.TagMonthlyUsage{
+				Month:     monthlyUsage.Month,
+				MemoCount: int32(monthlyUsage.MemoCount),
+			})
+		}
+		response.Usages = append(response.Usages, tagUsage)
+	}
+	if tagStats.Tree != nil {
+		response.Tree = convertTagTreeNodeFromStore(tagStats.Tree)
+	}
+	return response, nil
+}
+
+func convertTagCoOccurrencesFromStore(coOccurrences []*store.TagCoOccurrence) []*apiv2pb.TagCoOccurrence {
+	list := []*apiv2pb.TagCoOccurrence{}
+	for _, coOccurrence := range coOccurrences {
+		list = append(list, &apiv2pb.TagCoOccurrence{
+			Tag:       coOccurrence.Tag,
+			OtherTag:  coOccurrence.OtherTag,
+			MemoCount: int32(coOccurrence.MemoCount),
+		})
+	}
+	return list
+}
+
+func convertTagTreeNodeFromStore(node *store.TagTreeNode) *apiv2pb.TagTreeNode {
+	tagTreeNode := &apiv2pb.TagTreeNode{
+		Tag:       node.Tag,
+		MemoCount: int32(node.MemoCount),
+	}
+	for _, child := range node.Children {
+		tagTreeNode.Children = append(tagTreeNode.Children, convertTagTreeNodeFromStore(child))
+	}
+	return tagTreeNode
+}
+
 func (s *APIV2Service) convertTagFromStore(ctx context.Context, tag *store.Tag) (*apiv2pb.Tag, error) {
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
 		ID: &tag.CreatorID,
@@ -214,26 +441,17 @@ func (s *APIV2Service) convertTagFromStore(ctx context.Context, tag *store.Tag)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get user")
 	}
-	return &apiv2pb.Tag{
+	tagMessage := &apiv2pb.Tag{
 		Name:    tag.Name,
 		Creator: fmt.Sprintf("%s%s", UserNamePrefix, user.Username),
-	}, nil
-}
-
-var tagRegexp = regexp.MustCompile(`#([^\s#,]+)`)
-
-func findTagListFromMemoContent(memoContent string) []string {
-	tagMapSet := make(map[string]bool)
-	matches := tagRegexp.FindAllStringSubmatch(memoContent, -1)
-	for _, v := range matches {
-		tagName := v[1]
-		tagMapSet[tagName] = true
+		Aliases: tag.Aliases,
 	}
-
-	tagList := []string{}
-	for tag := range tagMapSet {
-		tagList = append(tagList, tag)
+	if payload := tag.Payload; payload != nil {
+		tagMessage.Color = payload.Color
+		tagMessage.Icon = payload.Icon
+		tagMessage.Description = payload.Description
+		tagMessage.SortWeight = payload.SortWeight
+		tagMessage.Favorite = payload.Favorite
 	}
-	sort.Strings(tagList)
-	return tagList
+	return tagMessage, nil
 }
@@ -237,8 +237,6 @@ func (s *APIV2Service) GetUserSetting(ctx context.Context, _ *apiv2pb.GetUserSet
 			userSettingMessage.MarkWithTag = setting.GetMarkWithTag()
 		} else if setting.Key == storepb.UserSettingKey_USER_SETTING_CUSTOM_SHORTCUT {
 			userSettingMessage.CustomShortcut = setting.GetCustomShortcut()
-		} else if setting.Key == storepb.UserSettingKey_USER_SETTING_FAV_TAG {
-			userSettingMessage.FavTag = setting.GetFavTag()
 		} else if setting.Key == storepb.UserSettingKey_USER_SETTING_REF_PREVIEW {
 			userSettingMessage.RefPreview = setting.GetRefPreview()
 		} else if setting.Key == storepb.UserSettingKey_USER_SETTING_SHOW_TODO_PAGE {
@@ -263,8 +261,24 @@ func (s *APIV2Service) GetUserSetting(ctx context.Context, _ *apiv2pb.GetUserSet
 			userSettingMessage.HideFullScreen = setting.GetHideFullScreen()
 		} else if setting.Key == storepb.UserSettingKey_USER_SETTING_SYS_SHORTCUT_CONFIG {
 			userSettingMessage.SysShortcutConfig = setting.GetSysShortcutConfig()
+		} else if setting.Key == storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID {
+			userSettingMessage.TelegramUserId = setting.GetTelegramUserId()
 		}
 	}
+	// The favorite tags are kept in the tag metadata, which replaces the fav_tag setting.
+	tags, err := s.Store.ListTags(ctx, &store.FindTag{
+		CreatorID: user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	favoriteTags := []string{}
+	for _, tag := range tags {
+		if tag.Payload.Favorite {
+			favoriteTags = append(favoriteTags, tag.Name)
+		}
+	}
+	userSettingMessage.FavTag = strings.Join(favoriteTags, ",")
 	return &apiv2pb.GetUserSettingResponse{
 		Setting: userSettingMessage,
 	}, nil
@@ -342,14 +356,14 @@ func (s *APIV2Service) UpdateUserSetting(ctx context.Context, request *apiv2pb.U
 				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 			}
 		} else if field == "fav_tag" {
-			if _, err := s.Store.UpsertUserSettingV1(ctx, &storepb.UserSetting{
-				UserId: user.ID,
-				Key:    storepb.UserSettingKey_USER_SETTING_FAV_TAG,
-				Value: &storepb.UserSetting_FavTag{
-					FavTag: request.Setting.FavTag,
-				},
-			}); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			favoriteTags := []string{}
+			for _, tag := range strings.Split(request.Setting.FavTag, ",") {
+				if tag = strings.TrimSpace(tag); tag != "" {
+					favoriteTags = append(favoriteTags, tag)
+				}
+			}
+			if err := s.Store.SetFavoriteTags(ctx, user.ID, favoriteTags); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to set favorite tags: %v", err)
 			}
 		} else if field == "ref_preview" {
 			if _, err := s.Store.UpsertUserSettingV1(ctx, &storepb.UserSetting{
@@ -471,6 +485,20 @@ func (s *APIV2Service) UpdateUserSetting(ctx context.Context, request *apiv2pb.U
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 			}
+		} else if field == "telegram_user_id" {
+			// Linking an account needs the proof of its ownership, so only unlinking is allowed here.
+			if strings.TrimSpace(request.Setting.TelegramUserId) != "" {
+				return nil, status.Errorf(codes.InvalidArgument, "telegram accounts are linked by sending /link <code> to the bot, with a code from CreateTelegramLinkCode")
+			}
+			if _, err := s.Store.UpsertUserSettingV1(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID,
+				Value: &storepb.UserSetting_TelegramUserId{
+					TelegramUserId: "",
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
 		}
@@ -559,6 +587,33 @@ func (s *APIV2Service) ListUserAccessTokens(ctx context.Context, request *apiv2p
 	return response, nil
 }
 
+func (s *APIV2Service) CreateTelegramLinkCode(ctx context.Context, request *apiv2pb.CreateTelegramLinkCodeRequest) (*apiv2pb.CreateTelegramLinkCodeResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	username, err := ExtractUsernameFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	// The users can only link their own telegram accounts.
+	if user.Username != username {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	code, expiresAt, err := s.Store.CreateTelegramLinkCode(user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create telegram link code: %v", err)
+	}
+	return &apiv2pb.CreateTelegramLinkCodeResponse{
+		Code:      code,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
 func (s *APIV2Service) CreateUserAccessToken(ctx context.Context, request *apiv2pb.CreateUserAccessTokenRequest) (*apiv2pb.CreateUserAccessTokenResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	"github.com/usememos/memos/server/profile"
+	webhookdispatcher "github.com/usememos/memos/server/service/webhook_dispatcher"
 	"github.com/usememos/memos/store"
 )
 
@@ -26,29 +27,37 @@ type APIV2Service struct {
 	apiv2pb.UnimplementedInboxServiceServer
 	apiv2pb.UnimplementedActivityServiceServer
 	apiv2pb.UnimplementedWebhookServiceServer
+	apiv2pb.UnimplementedSavedSearchServiceServer
+	apiv2pb.UnimplementedMemoRelationServiceServer
 
 	Secret  string
 	Profile *profile.Profile
 	Store   *store.Store
 
-	grpcServer     *grpc.Server
-	grpcServerPort int
+	grpcServer        *grpc.Server
+	grpcServerPort    int
+	webhookDispatcher *webhookdispatcher.WebhookDispatcher
+	// randomWalkSessions are the recent memos of the RandomWalk sessions.
+	randomWalkSessions *randomWalkSessionCache
 }
 
-func NewAPIV2Service(secret string, profile *profile.Profile, store *store.Store, grpcServerPort int) *APIV2Service {
+func NewAPIV2Service(secret string, profile *profile.Profile, store *store.Store, grpcServerPort int, webhookDispatcher *webhookdispatcher.WebhookDispatcher) *APIV2Service {
 	grpc.EnableTracing = true
 	authProvider := NewGRPCAuthInterceptor(store, secret)
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			newGuardInterceptor(store),
 			authProvider.AuthenticationInterceptor,
 		),
 	)
 	apiv2Service := &APIV2Service{
-		Secret:         secret,
-		Profile:        profile,
-		Store:          store,
-		grpcServer:     grpcServer,
-		grpcServerPort: grpcServerPort,
+		Secret:             secret,
+		Profile:            profile,
+		Store:              store,
+		grpcServer:         grpcServer,
+		grpcServerPort:     grpcServerPort,
+		webhookDispatcher:  webhookDispatcher,
+		randomWalkSessions: newRandomWalkSessionCache(),
 	}
 
 	apiv2pb.RegisterSystemServiceServer(grpcServer, apiv2Service)
@@ -60,6 +69,8 @@ func NewAPIV2Service(secret string, profile *profile.Profile, store *store.Store
 	apiv2pb.RegisterInboxServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterActivityServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterWebhookServiceServer(grpcServer, apiv2Service)
+	apiv2pb.RegisterSavedSearchServiceServer(grpcServer, apiv2Service)
+	apiv2pb.RegisterMemoRelationServiceServer(grpcServer, apiv2Service)
 	reflection.Register(grpcServer)
 
 	return apiv2Service
@@ -110,6 +121,12 @@ func (s *APIV2Service) RegisterGateway(ctx context.Context, e *echo.Echo) error
 	if err := apiv2pb.RegisterWebhookServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
+	if err := apiv2pb.RegisterSavedSearchServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	if err := apiv2pb.RegisterMemoRelationServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
 	e.Any("/api/v2/*", echo.WrapHandler(gwMux))
 
 	// GRPC web proxy.
@@ -124,3 +141,17 @@ func (s *APIV2Service) RegisterGateway(ctx context.Context, e *echo.Echo) error
 
 	return nil
 }
+
+// newGuardInterceptor holds the requests while the database is being restored.
+// The gateway and the grpc-web requests are held here too, as the echo server skips them.
+func newGuardInterceptor(s *store.Store) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var response any
+		err := s.Guard(func() error {
+			var err error
+			response, err = handler(ctx, request)
+			return err
+		})
+		return response, err
+	}
+}
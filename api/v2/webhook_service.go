@@ -0,0 +1,339 @@
+package v2
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV2Service) CreateWebhook(ctx context.Context, request *apiv2pb.CreateWebhookRequest) (*apiv2pb.CreateWebhookResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if request.Url == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url is required")
+	}
+
+	secret, err := util.RandomString(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+	}
+	webhook, err := s.Store.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      request.Name,
+		Url:       request.Url,
+		Filter:    convertWebhookFilterToStore(request.Filter),
+		Secret:    secret,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
+	}
+	// The generated secret is only returned here, so the receiver can be set up to verify the signatures.
+	webhookMessage := convertWebhookFromStore(webhook)
+	webhookMessage.Secret = webhook.Secret
+	return &apiv2pb.CreateWebhookResponse{
+		Webhook: webhookMessage,
+	}, nil
+}
+
+func (s *APIV2Service) GetWebhook(ctx context.Context, request *apiv2pb.GetWebhookRequest) (*apiv2pb.GetWebhookResponse, error) {
+	webhook, err := s.getWebhookOwnedByCurrentUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2pb.GetWebhookResponse{
+		Webhook: convertWebhookFromStore(webhook),
+	}, nil
+}
+
+func (s *APIV2Service) ListWebhooks(ctx context.Context, request *apiv2pb.ListWebhooksRequest) (*apiv2pb.ListWebhooksResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	// Webhooks carry the receiver urls, so only the creator can list them.
+	if request.CreatorId != 0 && request.CreatorId != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	webhooks, err := s.Store.ListWebhooks(ctx, &store.FindWebhook{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhooks: %v", err)
+	}
+
+	response := &apiv2pb.ListWebhooksResponse{
+		Webhooks: []*apiv2pb.Webhook{},
+	}
+	for _, webhook := range webhooks {
+		response.Webhooks = append(response.Webhooks, convertWebhookFromStore(webhook))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) UpdateWebhook(ctx context.Context, request *apiv2pb.UpdateWebhookRequest) (*apiv2pb.UpdateWebhookResponse, error) {
+	if request.Webhook == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	webhook, err := s.getWebhookOwnedByCurrentUser(ctx, request.Webhook.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateWebhook{
+		ID: webhook.Id,
+	}
+	for _, field := range request.UpdateMask.Paths {
+		if field == "name" {
+			if request.Webhook.Name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
+			update.Name = &request.Webhook.Name
+		} else if field == "url" {
+			if request.Webhook.Url == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "url is required")
+			}
+			update.URL = &request.Webhook.Url
+		} else if field == "row_status" {
+			rowStatus := convertWebhookRowStatusToStore(request.Webhook.RowStatus)
+			update.RowStatus = &rowStatus
+		} else if field == "filter" {
+			update.Filter = convertWebhookFilterToStore(request.Webhook.Filter)
+		} else if field == "secret" {
+			// An empty secret rotates to a generated one.
+			secret := request.Webhook.Secret
+			if secret == "" {
+				if secret, err = util.RandomString(32); err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+				}
+			}
+			update.Secret = &secret
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported field in update mask: %s", field)
+		}
+	}
+
+	webhook, err = s.Store.UpdateWebhook(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
+	}
+	webhookMessage := convertWebhookFromStore(webhook)
+	if update.Secret != nil {
+		webhookMessage.Secret = webhook.Secret
+	}
+	return &apiv2pb.UpdateWebhookResponse{
+		Webhook: webhookMessage,
+	}, nil
+}
+
+func (s *APIV2Service) DeleteWebhook(ctx context.Context, request *apiv2pb.DeleteWebhookRequest) (*apiv2pb.DeleteWebhookResponse, error) {
+	webhook, err := s.getWebhookOwnedByCurrentUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.DeleteWebhook(ctx, &store.DeleteWebhook{
+		ID: webhook.Id,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
+	}
+	return &apiv2pb.DeleteWebhookResponse{}, nil
+}
+
+func (s *APIV2Service) ListWebhookDeliveries(ctx context.Context, request *apiv2pb.ListWebhookDeliveriesRequest) (*apiv2pb.ListWebhookDeliveriesResponse, error) {
+	webhook, err := s.getWebhookOwnedByCurrentUser(ctx, request.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryFind := &store.FindWebhookDelivery{
+		WebhookID: &webhook.Id,
+	}
+	if request.PageSize != 0 {
+		offset := int(request.Page * request.PageSize)
+		limit := int(request.PageSize)
+		deliveryFind.Offset = &offset
+		deliveryFind.Limit = &limit
+	}
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, deliveryFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	response := &apiv2pb.ListWebhookDeliveriesResponse{
+		Deliveries: []*apiv2pb.WebhookDelivery{},
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertWebhookDeliveryFromStore(delivery))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) RedeliverWebhookDelivery(ctx context.Context, request *apiv2pb.RedeliverWebhookDeliveryRequest) (*apiv2pb.RedeliverWebhookDeliveryResponse, error) {
+	webhook, err := s.getWebhookOwnedByCurrentUser(ctx, request.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.Store.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+		ID:        &request.Id,
+		WebhookID: &webhook.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+	}
+
+	redelivery, err := s.webhookDispatcher.Redeliver(ctx, delivery)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook delivery: %v", err)
+	}
+	return &apiv2pb.RedeliverWebhookDeliveryResponse{
+		Delivery: convertWebhookDeliveryFromStore(redelivery),
+	}, nil
+}
+
+// getWebhookOwnedByCurrentUser returns the webhook with the given id, which must be created by the current user.
+func (s *APIV2Service) getWebhookOwnedByCurrentUser(ctx context.Context, id int32) (*storepb.Webhook, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	webhook, err := s.Store.GetWebhooks(ctx, &store.FindWebhook{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook: %v", err)
+	}
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	if webhook.CreatorId != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return webhook, nil
+}
+
+func convertWebhookFromStore(webhook *storepb.Webhook) *apiv2pb.Webhook {
+	return &apiv2pb.Webhook{
+		Id:          webhook.Id,
+		CreatorId:   webhook.CreatorId,
+		CreatedTime: timestamppb.New(time.Unix(webhook.CreatedTs, 0)),
+		UpdatedTime: timestamppb.New(time.Unix(webhook.UpdatedTs, 0)),
+		RowStatus:   convertWebhookRowStatusFromStore(webhook.RowStatus),
+		Name:        webhook.Name,
+		Url:         webhook.Url,
+		Filter:      convertWebhookFilterFromStore(webhook.Filter),
+		HasSecret:   webhook.Secret != "",
+	}
+}
+
+func convertWebhookDeliveryFromStore(delivery *store.WebhookDelivery) *apiv2pb.WebhookDelivery {
+	return &apiv2pb.WebhookDelivery{
+		Id:              delivery.ID,
+		WebhookId:       delivery.WebhookID,
+		CreatedTime:     timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+		UpdatedTime:     timestamppb.New(time.Unix(delivery.UpdatedTs, 0)),
+		ActivityType:    delivery.ActivityType,
+		Payload:         delivery.Payload,
+		Attempt:         delivery.Attempt,
+		Status:          convertWebhookDeliveryStatusFromStore(delivery.Status),
+		NextAttemptTime: timestamppb.New(time.Unix(delivery.NextAttemptTs, 0)),
+		StatusCode:      delivery.StatusCode,
+		LatencyMs:       delivery.LatencyMs,
+		ResponseBody:    delivery.ResponseBody,
+	}
+}
+
+func convertWebhookDeliveryStatusFromStore(status store.WebhookDeliveryStatus) apiv2pb.WebhookDelivery_Status {
+	switch status {
+	case store.WebhookDeliveryPending:
+		return apiv2pb.WebhookDelivery_PENDING
+	case store.WebhookDeliverySucceeded:
+		return apiv2pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryFailed:
+		return apiv2pb.WebhookDelivery_FAILED
+	default:
+		return apiv2pb.WebhookDelivery_STATUS_UNSPECIFIED
+	}
+}
+
+func convertWebhookRowStatusFromStore(rowStatus storepb.RowStatus) apiv2pb.RowStatus {
+	switch rowStatus {
+	case storepb.RowStatus_NORMAL:
+		return apiv2pb.RowStatus_ACTIVE
+	case storepb.RowStatus_ARCHIVED:
+		return apiv2pb.RowStatus_ARCHIVED
+	default:
+		return apiv2pb.RowStatus_ROW_STATUS_UNSPECIFIED
+	}
+}
+
+func convertWebhookRowStatusToStore(rowStatus apiv2pb.RowStatus) storepb.RowStatus {
+	switch rowStatus {
+	case apiv2pb.RowStatus_ARCHIVED:
+		return storepb.RowStatus_ARCHIVED
+	default:
+		return storepb.RowStatus_NORMAL
+	}
+}
+
+func convertWebhookFilterFromStore(filter *storepb.WebhookFilter) *apiv2pb.WebhookFilter {
+	if filter == nil {
+		return &apiv2pb.WebhookFilter{}
+	}
+	webhookFilter := &apiv2pb.WebhookFilter{
+		EventTypes: filter.EventTypes,
+		Tags:       filter.Tags,
+	}
+	for _, visibility := range filter.Visibilities {
+		webhookFilter.Visibilities = append(webhookFilter.Visibilities, convertVisibilityFromStore(store.Visibility(visibility)))
+	}
+	return webhookFilter
+}
+
+func convertWebhookFilterToStore(filter *apiv2pb.WebhookFilter) *storepb.WebhookFilter {
+	if filter == nil {
+		return &storepb.WebhookFilter{}
+	}
+	webhookFilter := &storepb.WebhookFilter{
+		EventTypes: filter.EventTypes,
+		Tags:       filter.Tags,
+	}
+	for _, visibility := range filter.Visibilities {
+		if visibility == apiv2pb.Visibility_VISIBILITY_UNSPECIFIED {
+			continue
+		}
+		webhookFilter.Visibilities = append(webhookFilter.Visibilities, visibility.String())
+	}
+	return webhookFilter
+}
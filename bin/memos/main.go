@@ -15,6 +15,7 @@ import (
 	"github.com/usememos/memos/internal/log"
 	"github.com/usememos/memos/server"
 	_profile "github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/server/service/backup"
 	"github.com/usememos/memos/server/service/metric"
 	"github.com/usememos/memos/store"
 	"github.com/usememos/memos/store/db"
@@ -93,6 +94,37 @@ var (
 	}
 )
 
+var restoreCmd = &cobra.Command{
+	Use:   "restore <backup file>",
+	Short: "Restore the database from a backup file, which is migrated if it is from an older version. Stop the server before restoring.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(_cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		if profile.Driver != "sqlite" {
+			log.Error(fmt.Sprintf("restore is not supported by the %s driver", profile.Driver))
+			return
+		}
+		dbDriver, err := db.NewDBDriver(profile)
+		if err != nil {
+			log.Error("failed to create db driver", zap.Error(err))
+			return
+		}
+		// Create the database first if there is none to restore into.
+		if err := dbDriver.Migrate(ctx); err != nil {
+			log.Error("failed to migrate db", zap.Error(err))
+			return
+		}
+
+		store := store.New(dbDriver, profile)
+		defer store.Close()
+		if err := backup.NewBackupRunner(store).RestoreBackup(ctx, args[0]); err != nil {
+			log.Error("failed to restore backup", zap.Error(err))
+			return
+		}
+		fmt.Printf("Database restored from %s\n", args[0])
+	},
+}
+
 func Execute() error {
 	defer log.Sync()
 	return rootCmd.Execute()
@@ -100,6 +132,7 @@ func Execute() error {
 
 func init() {
 	cobra.OnInitialize(initConfig)
+	rootCmd.AddCommand(restoreCmd)
 
 	rootCmd.PersistentFlags().StringVarP(&mode, "mode", "m", "demo", `mode of server, can be "prod" or "dev" or "demo"`)
 	rootCmd.PersistentFlags().StringVarP(&addr, "addr", "a", "", "address of server")
@@ -0,0 +1,254 @@
+// Package memofilter parses the CEL filters of the memos, e.g. `tag == "work" && !pinned`.
+package memofilter
+
+import (
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	v1alpha1 "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// CELAttributes are the CEL attributes of the memo filters.
+var CELAttributes = []cel.EnvOption{
+	cel.Variable("visibility", cel.StringType),
+	cel.Variable("row_status", cel.StringType),
+	cel.Variable("created_ts", cel.IntType),
+	cel.Variable("updated_ts", cel.IntType),
+	cel.Variable("tag", cel.StringType),
+	cel.Variable("content_search", cel.StringType),
+	cel.Variable("pinned", cel.BoolType),
+	cel.Variable("has_resource", cel.BoolType),
+	cel.Variable("has_task", cel.BoolType),
+	cel.Variable("related_to", cel.IntType),
+	// Deprecated: use created_ts < and created_ts > instead.
+	cel.Variable("created_ts_before", cel.IntType),
+	cel.Variable("created_ts_after", cel.IntType),
+}
+
+type memoFilterFieldSpec struct {
+	field     store.MemoFilterField
+	operators []store.MemoFilterOperator
+	// values are the valid values of the enum fields.
+	values []string
+}
+
+var (
+	memoFilterEqualityOperators = []store.MemoFilterOperator{store.MemoFilterEqual, store.MemoFilterNotEqual, store.MemoFilterIn}
+	memoFilterOrderOperators    = []store.MemoFilterOperator{store.MemoFilterEqual, store.MemoFilterNotEqual, store.MemoFilterLess, store.MemoFilterLessOrEqual, store.MemoFilterGreater, store.MemoFilterGreaterOrEqual, store.MemoFilterIn}
+	memoFilterBoolOperators     = []store.MemoFilterOperator{store.MemoFilterEqual, store.MemoFilterNotEqual}
+)
+
+var memoFilterFieldSpecs = map[string]*memoFilterFieldSpec{
+	"visibility":     {field: store.MemoFilterVisibility, operators: memoFilterEqualityOperators, values: []string{string(store.Public), string(store.Protected), string(store.Private)}},
+	"row_status":     {field: store.MemoFilterRowStatus, operators: memoFilterEqualityOperators, values: []string{string(store.Normal), string(store.Archived)}},
+	"created_ts":     {field: store.MemoFilterCreatedTs, operators: memoFilterOrderOperators},
+	"updated_ts":     {field: store.MemoFilterUpdatedTs, operators: memoFilterOrderOperators},
+	"tag":            {field: store.MemoFilterTag, operators: memoFilterEqualityOperators},
+	"content_search": {field: store.MemoFilterContentSearch, operators: memoFilterEqualityOperators},
+	"pinned":         {field: store.MemoFilterPinned, operators: memoFilterBoolOperators},
+	"has_resource":   {field: store.MemoFilterHasResource, operators: memoFilterBoolOperators},
+	"has_task":       {field: store.MemoFilterHasTask, operators: memoFilterBoolOperators},
+	"related_to":     {field: store.MemoFilterRelatedTo, operators: memoFilterEqualityOperators},
+}
+
+var memoFilterOperators = map[string]store.MemoFilterOperator{
+	operators.Equals:        store.MemoFilterEqual,
+	operators.NotEquals:     store.MemoFilterNotEqual,
+	operators.Less:          store.MemoFilterLess,
+	operators.LessEquals:    store.MemoFilterLessOrEqual,
+	operators.Greater:       store.MemoFilterGreater,
+	operators.GreaterEquals: store.MemoFilterGreaterOrEqual,
+	operators.In:            store.MemoFilterIn,
+}
+
+// reversedMemoFilterOperators are the operators with their operands swapped, e.g. `1 < created_ts` is `created_ts > 1`.
+var reversedMemoFilterOperators = map[store.MemoFilterOperator]store.MemoFilterOperator{
+	store.MemoFilterEqual:          store.MemoFilterEqual,
+	store.MemoFilterNotEqual:       store.MemoFilterNotEqual,
+	store.MemoFilterLess:           store.MemoFilterGreater,
+	store.MemoFilterLessOrEqual:    store.MemoFilterGreaterOrEqual,
+	store.MemoFilterGreater:        store.MemoFilterLess,
+	store.MemoFilterGreaterOrEqual: store.MemoFilterLessOrEqual,
+}
+
+// Parse parses the CEL filter to a memo filter,
+// e.g. `visibility == "PUBLIC" && (tag == "work" || !pinned) && created_ts > 1700000000`.
+func Parse(expression string) (*store.MemoFilter, error) {
+	e, err := cel.NewEnv(CELAttributes...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := e.Compile(expression)
+	if issues.Err() != nil {
+		return nil, errors.Errorf("found issue %v", issues)
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, errors.Errorf("filter must be a boolean expression, got %v", ast.OutputType())
+	}
+	expr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+	return convertMemoFilterFromExpr(expr.GetExpr())
+}
+
+func convertMemoFilterFromExpr(expr *v1alpha1.Expr) (*store.MemoFilter, error) {
+	switch {
+	case expr.GetIdentExpr() != nil:
+		// A boolean field alone, e.g. `pinned`.
+		name := expr.GetIdentExpr().Name
+		spec, ok := memoFilterFieldSpecs[name]
+		if !ok || spec.field != store.MemoFilterPinned && spec.field != store.MemoFilterHasResource && spec.field != store.MemoFilterHasTask {
+			return nil, errors.Errorf("%s is not a boolean field", name)
+		}
+		return &store.MemoFilter{
+			Kind:     store.MemoFilterCondition,
+			Field:    spec.field,
+			Operator: store.MemoFilterEqual,
+			Value:    true,
+		}, nil
+	case expr.GetCallExpr() != nil:
+		callExpr := expr.GetCallExpr()
+		switch callExpr.Function {
+		case operators.LogicalAnd, operators.LogicalOr:
+			kind := store.MemoFilterAnd
+			if callExpr.Function == operators.LogicalOr {
+				kind = store.MemoFilterOr
+			}
+			filter := &store.MemoFilter{Kind: kind}
+			for _, arg := range callExpr.Args {
+				child, err := convertMemoFilterFromExpr(arg)
+				if err != nil {
+					return nil, err
+				}
+				filter.Children = append(filter.Children, child)
+			}
+			return filter, nil
+		case operators.LogicalNot:
+			child, err := convertMemoFilterFromExpr(callExpr.Args[0])
+			if err != nil {
+				return nil, err
+			}
+			return &store.MemoFilter{
+				Kind:     store.MemoFilterNot,
+				Children: []*store.MemoFilter{child},
+			}, nil
+		}
+		if operator, ok := memoFilterOperators[callExpr.Function]; ok && len(callExpr.Args) == 2 {
+			return convertMemoFilterCondition(operator, callExpr.Args[0], callExpr.Args[1])
+		}
+		function := callExpr.Function
+		if displayName, ok := operators.FindReverse(function); ok && displayName != "" {
+			function = displayName
+		}
+		return nil, errors.Errorf("unsupported operator %s", function)
+	case expr.GetConstExpr() != nil:
+		return nil, errors.New("constant filter is not supported")
+	default:
+		return nil, errors.New("unsupported expression")
+	}
+}
+
+// convertMemoFilterCondition converts a comparison between a field and a constant to a memo filter condition.
+func convertMemoFilterCondition(operator store.MemoFilterOperator, left, right *v1alpha1.Expr) (*store.MemoFilter, error) {
+	if left.GetIdentExpr() == nil && right.GetIdentExpr() != nil && operator != store.MemoFilterIn {
+		left, right, operator = right, left, reversedMemoFilterOperators[operator]
+	}
+	if left.GetIdentExpr() == nil {
+		return nil, errors.Errorf("operator %s must have a field on the left", operator)
+	}
+	name := left.GetIdentExpr().Name
+
+	// The deprecated fields are shorthands of the created_ts comparisons.
+	if name == "created_ts_before" || name == "created_ts_after" {
+		if operator != store.MemoFilterEqual {
+			return nil, errors.Errorf("operator %s is not supported by %s", operator, name)
+		}
+		name, operator = "created_ts", store.MemoFilterLess
+		if left.GetIdentExpr().Name == "created_ts_after" {
+			operator = store.MemoFilterGreater
+		}
+	}
+
+	spec, ok := memoFilterFieldSpecs[name]
+	if !ok {
+		return nil, errors.Errorf("unknown field %s", name)
+	}
+	if !slices.Contains(spec.operators, operator) {
+		return nil, errors.Errorf("operator %s is not supported by %s", operator, name)
+	}
+
+	var value any
+	if operator == store.MemoFilterIn {
+		listExpr := right.GetListExpr()
+		if listExpr == nil {
+			return nil, errors.Errorf("operator in requires a list of constants for %s", name)
+		}
+		values := []any{}
+		for _, element := range listExpr.Elements {
+			v, err := convertMemoFilterValue(spec, name, element)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		value = values
+	} else {
+		v, err := convertMemoFilterValue(spec, name, right)
+		if err != nil {
+			return nil, err
+		}
+		value = v
+	}
+	return &store.MemoFilter{
+		Kind:     store.MemoFilterCondition,
+		Field:    spec.field,
+		Operator: operator,
+		Value:    value,
+	}, nil
+}
+
+func convertMemoFilterValue(spec *memoFilterFieldSpec, name string, expr *v1alpha1.Expr) (any, error) {
+	constExpr := expr.GetConstExpr()
+	if constExpr == nil {
+		return nil, errors.Errorf("%s must be compared with a constant", name)
+	}
+	switch v := constExpr.ConstantKind.(type) {
+	case *v1alpha1.Constant_StringValue:
+		if len(spec.values) > 0 && !slices.Contains(spec.values, v.StringValue) {
+			return nil, errors.Errorf("invalid %s %q", name, v.StringValue)
+		}
+		return v.StringValue, nil
+	case *v1alpha1.Constant_Int64Value:
+		return v.Int64Value, nil
+	case *v1alpha1.Constant_BoolValue:
+		return v.BoolValue, nil
+	default:
+		return nil, errors.Errorf("unsupported value for %s", name)
+	}
+}
+
+// ApplySavedSearch narrows the memo find to the memos of the saved search and sorts them by its order.
+func ApplySavedSearch(memoFind *store.FindMemo, savedSearch *storepb.SavedSearch) error {
+	if savedSearch.Filter != "" {
+		filter, err := Parse(savedSearch.Filter)
+		if err != nil {
+			return err
+		}
+		if memoFind.Filter != nil {
+			filter = &store.MemoFilter{
+				Kind:     store.MemoFilterAnd,
+				Children: []*store.MemoFilter{memoFind.Filter, filter},
+			}
+		}
+		memoFind.Filter = filter
+	}
+	if savedSearch.Order == storepb.SavedSearch_UPDATED_TS_DESC {
+		memoFind.OrderByUpdatedTs = true
+	}
+	return nil
+}
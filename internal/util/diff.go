@@ -0,0 +1,109 @@
+package util
+
+import (
+	"strings"
+)
+
+// DiffOperation is the kind of change a DiffLine represents.
+type DiffOperation string
+
+const (
+	DiffEqual  DiffOperation = "EQUAL"
+	DiffInsert DiffOperation = "INSERT"
+	DiffDelete DiffOperation = "DELETE"
+)
+
+// DiffLine is a single line of a line-based diff.
+type DiffLine struct {
+	Operation DiffOperation `json:"operation"`
+	Content   string        `json:"content"`
+}
+
+// maxDiffCells caps the size of the longest common subsequence table, so large texts cannot exhaust the memory.
+const maxDiffCells = 4_000_000
+
+// DiffLines returns the line-based diff that turns oldText into newText.
+// It is computed from the longest common subsequence of the two line lists, after the common prefix and suffix.
+// When the changed lines are too many, they are diffed as a whole replacement.
+func DiffLines(oldText, newText string) []DiffLine {
+	oldLines, newLines := splitLines(oldText), splitLines(newText)
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix && oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	diff := []DiffLine{}
+	for _, line := range oldLines[:prefix] {
+		diff = append(diff, DiffLine{Operation: DiffEqual, Content: line})
+	}
+	diff = append(diff, diffChangedLines(oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix])...)
+	for _, line := range oldLines[len(oldLines)-suffix:] {
+		diff = append(diff, DiffLine{Operation: DiffEqual, Content: line})
+	}
+	return diff
+}
+
+func diffChangedLines(oldLines, newLines []string) []DiffLine {
+	n, m := len(oldLines), len(newLines)
+	diff := []DiffLine{}
+	if (n+1)*(m+1) > maxDiffCells {
+		for _, line := range oldLines {
+			diff = append(diff, DiffLine{Operation: DiffDelete, Content: line})
+		}
+		for _, line := range newLines {
+			diff = append(diff, DiffLine{Operation: DiffInsert, Content: line})
+		}
+		return diff
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of oldLines[i:] and newLines[j:].
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case oldLines[i] == newLines[j]:
+			diff = append(diff, DiffLine{Operation: DiffEqual, Content: oldLines[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, DiffLine{Operation: DiffDelete, Content: oldLines[i]})
+			i++
+		default:
+			diff = append(diff, DiffLine{Operation: DiffInsert, Content: newLines[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		diff = append(diff, DiffLine{Operation: DiffDelete, Content: oldLines[i]})
+	}
+	for ; j < m; j++ {
+		diff = append(diff, DiffLine{Operation: DiffInsert, Content: newLines[j]})
+	}
+	return diff
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(text, "\n")
+}
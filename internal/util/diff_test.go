@@ -0,0 +1,78 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		oldText string
+		newText string
+		diff    []DiffLine
+	}{
+		{
+			oldText: "",
+			newText: "",
+			diff:    []DiffLine{},
+		},
+		{
+			oldText: "a\nb\nc",
+			newText: "a\nb\nc",
+			diff: []DiffLine{
+				{Operation: DiffEqual, Content: "a"},
+				{Operation: DiffEqual, Content: "b"},
+				{Operation: DiffEqual, Content: "c"},
+			},
+		},
+		{
+			oldText: "",
+			newText: "hello",
+			diff: []DiffLine{
+				{Operation: DiffInsert, Content: "hello"},
+			},
+		},
+		{
+			oldText: "a\nb\nc",
+			newText: "a\nc\nd",
+			diff: []DiffLine{
+				{Operation: DiffEqual, Content: "a"},
+				{Operation: DiffDelete, Content: "b"},
+				{Operation: DiffEqual, Content: "c"},
+				{Operation: DiffInsert, Content: "d"},
+			},
+		},
+		{
+			oldText: "#tag\nold line",
+			newText: "#tag\nnew line",
+			diff: []DiffLine{
+				{Operation: DiffEqual, Content: "#tag"},
+				{Operation: DiffDelete, Content: "old line"},
+				{Operation: DiffInsert, Content: "new line"},
+			},
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.diff, DiffLines(test.oldText, test.newText))
+	}
+}
+
+func TestDiffLinesLargeText(t *testing.T) {
+	oldLines, newLines := []string{"title"}, []string{"title"}
+	for i := 0; i < 3000; i++ {
+		oldLines = append(oldLines, "old "+strconv.Itoa(i))
+		newLines = append(newLines, "new "+strconv.Itoa(i))
+	}
+	oldLines, newLines = append(oldLines, "end"), append(newLines, "end")
+
+	// The changed lines are too many to diff, so they are replaced as a whole.
+	diff := DiffLines(strings.Join(oldLines, "\n"), strings.Join(newLines, "\n"))
+	require.Len(t, diff, 6002)
+	require.Equal(t, DiffLine{Operation: DiffEqual, Content: "title"}, diff[0])
+	require.Equal(t, DiffLine{Operation: DiffDelete, Content: "old 0"}, diff[1])
+	require.Equal(t, DiffLine{Operation: DiffInsert, Content: "new 0"}, diff[3001])
+	require.Equal(t, DiffLine{Operation: DiffEqual, Content: "end"}, diff[6001])
+}
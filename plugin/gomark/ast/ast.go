@@ -1 +1,19 @@
 package ast
+
+// The delimiters of the memos extensions to markdown, shared by the parser and the renderers.
+const (
+	// FoldBlockDelimiter opens a fold block with its summary on the same line, and closes it on a line of its own.
+	FoldBlockDelimiter   = "%%%"
+	SpoilerDelimiter     = "||"
+	SuperscriptDelimiter = "^"
+	SubscriptDelimiter   = "~"
+	HighlightDelimiter   = "=="
+	MathDelimiter        = "$"
+	// MathBlockDelimiter wraps the math block, which may span lines and ends at the end of a line.
+	MathBlockDelimiter = "$$"
+	// FootnotePrefix starts the label of a footnote reference, e.g. [^1], and of its definition, e.g. [^1]: text.
+	FootnotePrefix = "[^"
+)
+
+// DiagramLanguages are the languages of the code blocks which are parsed as diagrams.
+var DiagramLanguages = []string{"mermaid", "plantuml"}
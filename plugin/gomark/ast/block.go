@@ -114,3 +114,222 @@ func (n *Blockquote) String() string {
 	}
 	return str
 }
+
+type UnorderedList struct {
+	BaseBlock
+
+	// Symbol is "*" or "-" or "+".
+	Symbol string
+	// Children are the list items.
+	Children []Node
+}
+
+var NodeTypeUnorderedList = NewNodeType("UnorderedList")
+
+func (*UnorderedList) Type() NodeType {
+	return NodeTypeUnorderedList
+}
+
+func (n *UnorderedList) String() string {
+	str := n.Type().String() + " " + n.Symbol
+	for _, child := range n.Children {
+		str += " " + child.String()
+	}
+	return str
+}
+
+type OrderedList struct {
+	BaseBlock
+
+	// Start is the number of the first item.
+	Start int
+	// Children are the list items.
+	Children []Node
+}
+
+var NodeTypeOrderedList = NewNodeType("OrderedList")
+
+func (*OrderedList) Type() NodeType {
+	return NodeTypeOrderedList
+}
+
+func (n *OrderedList) String() string {
+	str := n.Type().String() + " " + fmt.Sprintf("%d", n.Start)
+	for _, child := range n.Children {
+		str += " " + child.String()
+	}
+	return str
+}
+
+type TaskList struct {
+	BaseBlock
+
+	// Symbol is "*" or "-" or "+".
+	Symbol string
+	// Children are the list items.
+	Children []Node
+}
+
+var NodeTypeTaskList = NewNodeType("TaskList")
+
+func (*TaskList) Type() NodeType {
+	return NodeTypeTaskList
+}
+
+func (n *TaskList) String() string {
+	str := n.Type().String() + " " + n.Symbol
+	for _, child := range n.Children {
+		str += " " + child.String()
+	}
+	return str
+}
+
+type ListItem struct {
+	BaseBlock
+
+	// Complete is whether the item of a task list is checked.
+	Complete bool
+	Children []Node
+	// Sublists are the lists nested in the item by indentation.
+	Sublists []Node
+}
+
+var NodeTypeListItem = NewNodeType("ListItem")
+
+func (*ListItem) Type() NodeType {
+	return NodeTypeListItem
+}
+
+func (n *ListItem) String() string {
+	str := n.Type().String()
+	if n.Complete {
+		str += " [x]"
+	}
+	for _, child := range n.Children {
+		str += " " + child.String()
+	}
+	for _, sublist := range n.Sublists {
+		str += " " + sublist.String()
+	}
+	return str
+}
+
+type Table struct {
+	BaseBlock
+
+	Header []*TableCell
+	// Alignments are the alignments of the columns, which are "left", "center", "right" or "" by default.
+	Alignments []string
+	// Rows have as many cells as the header.
+	Rows [][]*TableCell
+}
+
+var NodeTypeTable = NewNodeType("Table")
+
+func (*Table) Type() NodeType {
+	return NodeTypeTable
+}
+
+func (n *Table) String() string {
+	str := n.Type().String()
+	for i, cell := range n.Header {
+		str += " " + cell.String() + " " + n.Alignments[i]
+	}
+	for _, row := range n.Rows {
+		str += " |"
+		for _, cell := range row {
+			str += " " + cell.String()
+		}
+	}
+	return str
+}
+
+// TableCell is a cell of a table with inline content.
+type TableCell struct {
+	Children []Node
+}
+
+func (c *TableCell) String() string {
+	str := "TableCell"
+	for _, child := range c.Children {
+		str += " " + child.String()
+	}
+	return str
+}
+
+type FoldBlock struct {
+	BaseBlock
+
+	Summary string
+	// Children are the blocks folded under the summary.
+	Children []Node
+}
+
+var NodeTypeFoldBlock = NewNodeType("FoldBlock")
+
+func (*FoldBlock) Type() NodeType {
+	return NodeTypeFoldBlock
+}
+
+func (n *FoldBlock) String() string {
+	str := n.Type().String() + " " + n.Summary
+	for _, child := range n.Children {
+		str += " " + child.String()
+	}
+	return str
+}
+
+type MathBlock struct {
+	BaseBlock
+
+	Content string
+}
+
+var NodeTypeMathBlock = NewNodeType("MathBlock")
+
+func (*MathBlock) Type() NodeType {
+	return NodeTypeMathBlock
+}
+
+func (n *MathBlock) String() string {
+	return n.Type().String() + " " + n.Content
+}
+
+// Diagram is a code block of a diagram language, e.g. mermaid.
+type Diagram struct {
+	BaseBlock
+
+	Language string
+	Content  string
+}
+
+var NodeTypeDiagram = NewNodeType("Diagram")
+
+func (*Diagram) Type() NodeType {
+	return NodeTypeDiagram
+}
+
+func (n *Diagram) String() string {
+	return n.Type().String() + " " + n.Language + " " + n.Content
+}
+
+type FootnoteDefinition struct {
+	BaseBlock
+
+	Label    string
+	Children []Node
+}
+
+var NodeTypeFootnoteDefinition = NewNodeType("FootnoteDefinition")
+
+func (*FootnoteDefinition) Type() NodeType {
+	return NodeTypeFootnoteDefinition
+}
+
+func (n *FootnoteDefinition) String() string {
+	str := n.Type().String() + " " + n.Label
+	for _, child := range n.Children {
+		str += " " + child.String()
+	}
+	return str
+}
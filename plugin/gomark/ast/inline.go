@@ -155,3 +155,116 @@ func (*Strikethrough) Type() NodeType {
 func (n *Strikethrough) String() string {
 	return n.Type().String() + " " + n.Content
 }
+
+type WikiLink struct {
+	BaseInline
+
+	// Target is the text between the double brackets, e.g. "memo:123" or the title of a memo.
+	Target string
+}
+
+var NodeTypeWikiLink = NewNodeType("WikiLink")
+
+func (*WikiLink) Type() NodeType {
+	return NodeTypeWikiLink
+}
+
+func (n *WikiLink) String() string {
+	return n.Type().String() + " " + n.Target
+}
+
+type Spoiler struct {
+	BaseInline
+
+	Content string
+}
+
+var NodeTypeSpoiler = NewNodeType("Spoiler")
+
+func (*Spoiler) Type() NodeType {
+	return NodeTypeSpoiler
+}
+
+func (n *Spoiler) String() string {
+	return n.Type().String() + " " + n.Content
+}
+
+type Superscript struct {
+	BaseInline
+
+	Content string
+}
+
+var NodeTypeSuperscript = NewNodeType("Superscript")
+
+func (*Superscript) Type() NodeType {
+	return NodeTypeSuperscript
+}
+
+func (n *Superscript) String() string {
+	return n.Type().String() + " " + n.Content
+}
+
+type Subscript struct {
+	BaseInline
+
+	Content string
+}
+
+var NodeTypeSubscript = NewNodeType("Subscript")
+
+func (*Subscript) Type() NodeType {
+	return NodeTypeSubscript
+}
+
+func (n *Subscript) String() string {
+	return n.Type().String() + " " + n.Content
+}
+
+type Highlight struct {
+	BaseInline
+
+	Content string
+}
+
+var NodeTypeHighlight = NewNodeType("Highlight")
+
+func (*Highlight) Type() NodeType {
+	return NodeTypeHighlight
+}
+
+func (n *Highlight) String() string {
+	return n.Type().String() + " " + n.Content
+}
+
+type Math struct {
+	BaseInline
+
+	Content string
+}
+
+var NodeTypeMath = NewNodeType("Math")
+
+func (*Math) Type() NodeType {
+	return NodeTypeMath
+}
+
+func (n *Math) String() string {
+	return n.Type().String() + " " + n.Content
+}
+
+type FootnoteReference struct {
+	BaseInline
+
+	Label string
+}
+
+var NodeTypeFootnoteReference = NewNodeType("FootnoteReference")
+
+func (*FootnoteReference) Type() NodeType {
+	return NodeTypeFootnoteReference
+}
+
+func (n *FootnoteReference) String() string {
+	return n.Type().String() + " " + n.Label
+}
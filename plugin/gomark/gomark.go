@@ -1 +1,151 @@
 package gomark
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+// The markers wrap the token index of a tag candidate in its content, using characters of the private use area.
+const (
+	tagMarkerStart = "\uE000"
+	tagMarkerEnd   = "\uE001"
+)
+
+// ParseTags returns the tag nodes of the content in document order.
+func ParseTags(content string) ([]*ast.Tag, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return nil, err
+	}
+	return collectTags(nodes), nil
+}
+
+// ParseWikiLinks returns the wiki link nodes of the content in document order, e.g. [[memo:123]] and [[Title text]].
+func ParseWikiLinks(content string) ([]*ast.WikiLink, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return nil, err
+	}
+	wikiLinks := []*ast.WikiLink{}
+	walkInlines(nodes, func(node ast.Node) {
+		if wikiLink, ok := node.(*ast.WikiLink); ok {
+			wikiLinks = append(wikiLinks, wikiLink)
+		}
+	})
+	return wikiLinks, nil
+}
+
+func collectTags(nodes []ast.Node) []*ast.Tag {
+	tags := []*ast.Tag{}
+	walkInlines(nodes, func(node ast.Node) {
+		if tag, ok := node.(*ast.Tag); ok {
+			tags = append(tags, tag)
+		}
+	})
+	return tags
+}
+
+// walkInlines calls visit on the inline nodes of the blocks in document order.
+func walkInlines(nodes []ast.Node, visit func(node ast.Node)) {
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *ast.Paragraph:
+			walkInlines(n.Children, visit)
+		case *ast.Heading:
+			walkInlines(n.Children, visit)
+		case *ast.Blockquote:
+			walkInlines(n.Children, visit)
+		case *ast.UnorderedList:
+			walkInlines(n.Children, visit)
+		case *ast.OrderedList:
+			walkInlines(n.Children, visit)
+		case *ast.TaskList:
+			walkInlines(n.Children, visit)
+		case *ast.ListItem:
+			walkInlines(n.Children, visit)
+			walkInlines(n.Sublists, visit)
+		case *ast.FoldBlock:
+			walkInlines(n.Children, visit)
+		case *ast.FootnoteDefinition:
+			walkInlines(n.Children, visit)
+		case *ast.Table:
+			for _, cell := range n.Header {
+				walkInlines(cell.Children, visit)
+			}
+			for _, row := range n.Rows {
+				for _, cell := range row {
+					walkInlines(cell.Children, visit)
+				}
+			}
+		default:
+			visit(node)
+		}
+	}
+}
+
+// RewriteTags replaces every tag of the content with the one returned by rewrite, which removes the tag when it is empty.
+// Only the tag nodes are rewritten, so a # in code or links and the rest of the content are kept as is.
+func RewriteTags(content string, rewrite func(tag string) string) (string, error) {
+	tokens := tokenizer.Tokenize(content)
+	values := make([]string, len(tokens))
+	for i, token := range tokens {
+		values[i] = token.Value
+	}
+
+	// The parser decides on the token types only, so marking the value of the first content token
+	// of every tag candidate tells which candidates become tag nodes.
+	tagParser := parser.NewTagParser()
+	candidateSizes := map[int]int{}
+	for i := range tokens {
+		if size, ok := tagParser.Match(tokens[i:]); ok {
+			candidateSizes[i] = size
+			tokens[i+1].Value = tagMarkerStart + strconv.Itoa(i) + tagMarkerEnd + tokens[i+1].Value
+		}
+	}
+	nodes, err := parser.Parse(tokens)
+	if err != nil {
+		return "", err
+	}
+
+	tagSizes := map[int]int{}
+	for _, tag := range collectTags(nodes) {
+		marker, _, found := strings.Cut(strings.TrimPrefix(tag.Content, tagMarkerStart), tagMarkerEnd)
+		index, err := strconv.Atoi(marker)
+		if !found || err != nil || candidateSizes[index] == 0 {
+			return "", errors.Errorf("unknown tag %q", tag.Content)
+		}
+		tagSizes[index] = candidateSizes[index]
+	}
+
+	var builder strings.Builder
+	for i := 0; i < len(values); i++ {
+		size, ok := tagSizes[i]
+		if !ok {
+			builder.WriteString(values[i])
+			continue
+		}
+		tag := strings.Join(values[i+1:i+size], "")
+		newTag := rewrite(tag)
+		if newTag == tag {
+			builder.WriteString(values[i])
+			continue
+		}
+		i += size - 1
+		if newTag != "" {
+			builder.WriteString("#" + newTag)
+			continue
+		}
+		// Drop the space after a removed tag, unless the tag was in the middle of a word.
+		written := builder.String()
+		if (written == "" || strings.HasSuffix(written, " ") || strings.HasSuffix(written, "\n")) && i+1 < len(tokens) && tokens[i+1].Type == tokenizer.Space {
+			i++
+		}
+	}
+	return builder.String(), nil
+}
@@ -0,0 +1,120 @@
+package gomark
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteTags(t *testing.T) {
+	renameWork := func(tag string) string {
+		if tag == "work" {
+			return "job"
+		}
+		if strings.HasPrefix(tag, "work/") {
+			return "job" + strings.TrimPrefix(tag, "work")
+		}
+		return tag
+	}
+	removeWork := func(tag string) string {
+		if tag == "work" {
+			return ""
+		}
+		return tag
+	}
+	tests := []struct {
+		content string
+		rewrite func(tag string) string
+		want    string
+	}{
+		{
+			content: "#work and #workshop",
+			rewrite: renameWork,
+			want:    "#job and #workshop",
+		},
+		{
+			content: "#work/project\n> quoted #work",
+			rewrite: renameWork,
+			want:    "#job/project\n> quoted #job",
+		},
+		{
+			content: "`#work` in code\n```\n#work\n```\n#work",
+			rewrite: renameWork,
+			want:    "`#work` in code\n```\n#work\n```\n#job",
+		},
+		{
+			content: "# Heading #work",
+			rewrite: renameWork,
+			want:    "# Heading #job",
+		},
+		{
+			content: "- [ ] #work\n  1. nested #work/project",
+			rewrite: renameWork,
+			want:    "- [ ] #job\n  1. nested #job/project",
+		},
+		{
+			content: "| #work | a\\|b |\n| --- | --- |\n| #work/project | |",
+			rewrite: renameWork,
+			want:    "| #job | a\\|b |\n| --- | --- |\n| #job/project | |",
+		},
+		{
+			content: "#work meeting #life",
+			rewrite: removeWork,
+			want:    "meeting #life",
+		},
+		{
+			content: "meeting #work #life\n#work",
+			rewrite: removeWork,
+			want:    "meeting #life\n",
+		},
+		{
+			content: "#c++ and #c",
+			rewrite: func(tag string) string {
+				if tag == "c" {
+					return "go"
+				}
+				return tag
+			},
+			want: "#c++ and #go",
+		},
+	}
+	for _, test := range tests {
+		content, err := RewriteTags(test.content, test.rewrite)
+		require.NoError(t, err)
+		require.Equal(t, test.want, content, test.content)
+	}
+}
+
+func TestParseWikiLinks(t *testing.T) {
+	tests := []struct {
+		content string
+		want    []string
+	}{
+		{
+			content: "See [[memo:12]] and [[Weekly notes]]",
+			want:    []string{"memo:12", "Weekly notes"},
+		},
+		{
+			content: "# [[Heading link]]\n> [[Quoted link]]",
+			want:    []string{"Heading link", "Quoted link"},
+		},
+		{
+			content: "`[[memo:1]]` and [link](https://example.com) and [[]]",
+			want:    []string{},
+		},
+		{
+			content: "```\n[[memo:1]]\n```",
+			want:    []string{},
+		},
+	}
+	for _, test := range tests {
+		wikiLinks, err := ParseWikiLinks(test.content)
+		require.NoError(t, err)
+		targets := []string{}
+		for _, wikiLink := range wikiLinks {
+			targets = append(targets, wikiLink.Target)
+		}
+		require.Equal(t, test.want, targets)
+	}
+}
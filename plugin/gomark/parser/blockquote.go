@@ -36,13 +36,17 @@ func (*BlockquoteParser) Match(tokens []*tokenizer.Token) (int, bool) {
 }
 
 func (p *BlockquoteParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (p *BlockquoteParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
 	size, ok := p.Match(tokens)
 	if size == 0 || !ok {
 		return nil, errors.New("not matched")
 	}
 
 	contentTokens := tokens[2:size]
-	children, err := ParseInline(contentTokens)
+	children, err := parser.ParseInline(contentTokens)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+// matchDelimiter returns whether the tokens start with the delimiter, whose characters are all single tokens.
+func matchDelimiter(tokens []*tokenizer.Token, delimiter string) bool {
+	if len(tokens) < len(delimiter) {
+		return false
+	}
+	for i, c := range delimiter {
+		if tokens[i].Value != string(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchDelimitedInline matches some content between a pair of delimiters on one line.
+// The content does not start with the delimiter or a space, nor end with a space.
+func matchDelimitedInline(tokens []*tokenizer.Token, delimiter string) (int, bool) {
+	if !matchDelimiter(tokens, delimiter) {
+		return 0, false
+	}
+	contentStart := len(delimiter)
+	if len(tokens) <= contentStart || tokens[contentStart].Type == tokenizer.Space || tokens[contentStart].Value == delimiter[:1] {
+		return 0, false
+	}
+
+	for cursor := contentStart + 1; cursor < len(tokens); cursor++ {
+		if tokens[cursor].Type == tokenizer.Newline {
+			return 0, false
+		}
+		if matchDelimiter(tokens[cursor:], delimiter) {
+			if tokens[cursor-1].Type == tokenizer.Space {
+				return 0, false
+			}
+			return cursor + len(delimiter), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,48 @@
+package parser
+
+import (
+	"errors"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+// DiagramParser parses the code blocks of the diagram languages.
+type DiagramParser struct{}
+
+func NewDiagramParser() *DiagramParser {
+	return &DiagramParser{}
+}
+
+func (*DiagramParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	size, ok := NewCodeBlockParser().Match(tokens)
+	if !ok {
+		return 0, false
+	}
+	if !slices.Contains(ast.DiagramLanguages, tokens[3].Value) {
+		return 0, false
+	}
+	return size, true
+}
+
+func (p *DiagramParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	node, err := NewCodeBlockParser().Parse(tokens)
+	if err != nil {
+		return nil, err
+	}
+	codeBlock, ok := node.(*ast.CodeBlock)
+	if !ok {
+		return nil, errors.New("not matched")
+	}
+	return &ast.Diagram{
+		Language: codeBlock.Language,
+		Content:  codeBlock.Content,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestDiagramParser(t *testing.T) {
+	tests := []struct {
+		text    string
+		diagram ast.Node
+	}{
+		{
+			text:    "```go\nfmt.Println(1)\n```",
+			diagram: nil,
+		},
+		{
+			text:    "```mermaid\ngraph TD",
+			diagram: nil,
+		},
+		{
+			text: "```mermaid\ngraph TD\n  A --> B\n```\nafter",
+			diagram: &ast.Diagram{
+				Language: "mermaid",
+				Content:  "graph TD\n  A --> B",
+			},
+		},
+		{
+			text: "```plantuml\n@startuml\nA -> B\n@enduml\n```",
+			diagram: &ast.Diagram{
+				Language: "plantuml",
+				Content:  "@startuml\nA -> B\n@enduml",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewDiagramParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.diagram}), StringifyNodes([]ast.Node{node}))
+	}
+}
@@ -0,0 +1,81 @@
+package parser
+
+import (
+	"errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type FoldBlockParser struct{}
+
+func NewFoldBlockParser() *FoldBlockParser {
+	return &FoldBlockParser{}
+}
+
+func (*FoldBlockParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	_, closingStart, ok := matchFoldBlock(tokens)
+	if !ok {
+		return 0, false
+	}
+
+	// The newline after the closing delimiter belongs to the block, as in the code blocks.
+	size := closingStart + len(ast.FoldBlockDelimiter)
+	if size < len(tokens) {
+		size++
+	}
+	return size, true
+}
+
+func (p *FoldBlockParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (p *FoldBlockParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	summaryEnd, closingStart, _ := matchFoldBlock(tokens)
+	contentStart, contentEnd := summaryEnd+1, closingStart
+	if contentEnd > contentStart {
+		// The newline before the closing delimiter is not in the content.
+		contentEnd--
+	}
+	children, err := parser.Parse(tokens[contentStart:contentEnd])
+	if err != nil {
+		return nil, err
+	}
+	return &ast.FoldBlock{
+		Summary:  tokenizer.Stringify(tokens[len(ast.FoldBlockDelimiter):summaryEnd]),
+		Children: children,
+	}, nil
+}
+
+// matchFoldBlock returns the index of the newline after the summary, and the start of the closing delimiter line.
+func matchFoldBlock(tokens []*tokenizer.Token) (int, int, bool) {
+	if !matchDelimiter(tokens, ast.FoldBlockDelimiter) {
+		return 0, 0, false
+	}
+	summaryEnd := len(ast.FoldBlockDelimiter)
+	for summaryEnd < len(tokens) && tokens[summaryEnd].Type != tokenizer.Newline {
+		summaryEnd++
+	}
+
+	contentStart := summaryEnd + 1
+	for cursor := contentStart; cursor < len(tokens); cursor++ {
+		if cursor != contentStart && tokens[cursor-1].Type != tokenizer.Newline {
+			continue
+		}
+		if !matchDelimiter(tokens[cursor:], ast.FoldBlockDelimiter) {
+			continue
+		}
+		closingEnd := cursor + len(ast.FoldBlockDelimiter)
+		if closingEnd < len(tokens) && tokens[closingEnd].Type != tokenizer.Newline {
+			continue
+		}
+		return summaryEnd, cursor, true
+	}
+	return 0, 0, false
+}
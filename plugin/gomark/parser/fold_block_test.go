@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestFoldBlockParser(t *testing.T) {
+	tests := []struct {
+		text      string
+		foldBlock ast.Node
+	}{
+		{
+			text:      "%%%Hello",
+			foldBlock: nil,
+		},
+		{
+			text:      "%%%Hello\nworld%%%",
+			foldBlock: nil,
+		},
+		{
+			text: "%%%\n%%%",
+			foldBlock: &ast.FoldBlock{
+				Summary: "",
+			},
+		},
+		{
+			text: "%%% Hello\n# world\n- #tag\n%%%\nafter",
+			foldBlock: &ast.FoldBlock{
+				Summary: " Hello",
+				Children: []ast.Node{
+					&ast.Heading{
+						Level: 1,
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "world",
+							},
+						},
+					},
+					&ast.LineBreak{},
+					&ast.UnorderedList{
+						Symbol: "-",
+						Children: []ast.Node{
+							&ast.ListItem{
+								Children: []ast.Node{
+									&ast.Tag{
+										Content: "tag",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "%%%Hello\n\n%%%",
+			foldBlock: &ast.FoldBlock{
+				Summary: "Hello",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewFoldBlockParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.foldBlock}), StringifyNodes([]ast.Node{node}))
+	}
+}
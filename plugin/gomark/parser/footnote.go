@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type FootnoteReferenceParser struct{}
+
+func NewFootnoteReferenceParser() *FootnoteReferenceParser {
+	return &FootnoteReferenceParser{}
+}
+
+func (*FootnoteReferenceParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	size, _, ok := matchFootnoteLabel(tokens)
+	return size, ok
+}
+
+func (p *FootnoteReferenceParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, label, ok := matchFootnoteLabel(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	return &ast.FootnoteReference{
+		Label: label,
+	}, nil
+}
+
+type FootnoteDefinitionParser struct{}
+
+func NewFootnoteDefinitionParser() *FootnoteDefinitionParser {
+	return &FootnoteDefinitionParser{}
+}
+
+// Match matches a footnote label followed by a colon, a space and the content on the same line.
+func (*FootnoteDefinitionParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	cursor, _, ok := matchFootnoteLabel(tokens)
+	if !ok || len(tokens) < cursor+3 {
+		return 0, false
+	}
+	if tokens[cursor].Value != ":" || tokens[cursor+1].Type != tokenizer.Space {
+		return 0, false
+	}
+
+	cursor += 2
+	contentStart := cursor
+	for ; cursor < len(tokens); cursor++ {
+		if tokens[cursor].Type == tokenizer.Newline {
+			break
+		}
+	}
+	if cursor == contentStart {
+		return 0, false
+	}
+	return cursor, true
+}
+
+func (p *FootnoteDefinitionParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (p *FootnoteDefinitionParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	labelSize, label, _ := matchFootnoteLabel(tokens)
+	children, err := parser.ParseInline(tokens[labelSize+2 : size])
+	if err != nil {
+		return nil, err
+	}
+	return &ast.FootnoteDefinition{
+		Label:    label,
+		Children: children,
+	}, nil
+}
+
+// matchFootnoteLabel matches a footnote label, e.g. [^1], which has no spaces.
+func matchFootnoteLabel(tokens []*tokenizer.Token) (int, string, bool) {
+	if !matchDelimiter(tokens, ast.FootnotePrefix) {
+		return 0, "", false
+	}
+
+	cursor := len(ast.FootnotePrefix)
+	for ; cursor < len(tokens); cursor++ {
+		token := tokens[cursor]
+		if token.Type == tokenizer.RightSquareBracket {
+			break
+		}
+		if token.Type == tokenizer.Space || token.Type == tokenizer.Newline || token.Type == tokenizer.LeftSquareBracket {
+			return 0, "", false
+		}
+	}
+	if cursor == len(tokens) || cursor == len(ast.FootnotePrefix) {
+		return 0, "", false
+	}
+	return cursor + 1, tokenizer.Stringify(tokens[len(ast.FootnotePrefix):cursor]), true
+}
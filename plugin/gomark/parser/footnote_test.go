@@ -0,0 +1,87 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestFootnoteReferenceParser(t *testing.T) {
+	tests := []struct {
+		text              string
+		footnoteReference ast.Node
+	}{
+		{
+			text:              "[^]",
+			footnoteReference: nil,
+		},
+		{
+			text:              "[^a b]",
+			footnoteReference: nil,
+		},
+		{
+			text: "[^1]: text",
+			footnoteReference: &ast.FootnoteReference{
+				Label: "1",
+			},
+		},
+		{
+			text: "[^note-1] text",
+			footnoteReference: &ast.FootnoteReference{
+				Label: "note-1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewFootnoteReferenceParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.footnoteReference}), StringifyNodes([]ast.Node{node}))
+	}
+}
+
+func TestFootnoteDefinitionParser(t *testing.T) {
+	tests := []struct {
+		text               string
+		footnoteDefinition ast.Node
+	}{
+		{
+			text:               "[^1] text",
+			footnoteDefinition: nil,
+		},
+		{
+			text:               "[^1]: ",
+			footnoteDefinition: nil,
+		},
+		{
+			text: "[^1]: See **this** #tag\nafter",
+			footnoteDefinition: &ast.FootnoteDefinition{
+				Label: "1",
+				Children: []ast.Node{
+					&ast.Text{
+						Content: "See ",
+					},
+					&ast.Bold{
+						Symbol:  "*",
+						Content: "this",
+					},
+					&ast.Text{
+						Content: " ",
+					},
+					&ast.Tag{
+						Content: "tag",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewFootnoteDefinitionParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.footnoteDefinition}), StringifyNodes([]ast.Node{node}))
+	}
+}
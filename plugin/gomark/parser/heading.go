@@ -50,6 +50,10 @@ func (*HeadingParser) Match(tokens []*tokenizer.Token) (int, bool) {
 }
 
 func (p *HeadingParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (p *HeadingParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
 	size, ok := p.Match(tokens)
 	if size == 0 || !ok {
 		return nil, errors.New("not matched")
@@ -65,7 +69,7 @@ func (p *HeadingParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
 	}
 
 	contentTokens := tokens[level+1 : size]
-	children, err := ParseInline(contentTokens)
+	children, err := parser.ParseInline(contentTokens)
 	if err != nil {
 		return nil, err
 	}
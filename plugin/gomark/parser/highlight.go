@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type HighlightParser struct{}
+
+func NewHighlightParser() *HighlightParser {
+	return &HighlightParser{}
+}
+
+func (*HighlightParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	return matchDelimitedInline(tokens, ast.HighlightDelimiter)
+}
+
+func (p *HighlightParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	contentTokens := tokens[len(ast.HighlightDelimiter) : size-len(ast.HighlightDelimiter)]
+	return &ast.Highlight{
+		Content: tokenizer.Stringify(contentTokens),
+	}, nil
+}
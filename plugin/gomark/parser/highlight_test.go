@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestHighlightParser(t *testing.T) {
+	tests := []struct {
+		text      string
+		highlight ast.Node
+	}{
+		{
+			text:      "=Hello=",
+			highlight: nil,
+		},
+		{
+			text:      "== Hello ==",
+			highlight: nil,
+		},
+		{
+			text: "==Hello **world**==",
+			highlight: &ast.Highlight{
+				Content: "Hello **world**",
+			},
+		},
+		{
+			text: "==a==b==",
+			highlight: &ast.Highlight{
+				Content: "a",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewHighlightParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.highlight}), StringifyNodes([]ast.Node{node}))
+	}
+}
@@ -0,0 +1,252 @@
+package parser
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type listKind int
+
+const (
+	unorderedListKind listKind = iota
+	orderedListKind
+	taskListKind
+)
+
+// listItemMarker is the marker of a list item, e.g. "- ", "1. " or "- [x] ".
+type listItemMarker struct {
+	kind listKind
+	// symbol is "*" or "-" or "+" for the unordered and task lists, and "." or ")" for the ordered lists.
+	symbol   string
+	number   int
+	complete bool
+	// size is the number of the marker tokens, including the space after it.
+	size int
+}
+
+// listLine is a line of a list.
+type listLine struct {
+	// indent is the number of the leading spaces.
+	indent int
+	// contentTokens are the tokens after the indentation, without the newline.
+	contentTokens []*tokenizer.Token
+	// size is the number of all the tokens of the line, including the newline.
+	size int
+}
+
+var listParsers = []NestedParser{
+	NewTaskListParser(),
+	NewUnorderedListParser(),
+	NewOrderedListParser(),
+}
+
+func nextListLine(tokens []*tokenizer.Token) *listLine {
+	line := &listLine{}
+	for _, token := range tokens {
+		if token.Type != tokenizer.Space {
+			break
+		}
+		line.indent++
+	}
+	line.size = line.indent
+	for _, token := range tokens[line.indent:] {
+		line.size++
+		if token.Type == tokenizer.Newline {
+			break
+		}
+		line.contentTokens = append(line.contentTokens, token)
+	}
+	return line
+}
+
+// matchListItemMarker matches a list item marker followed by some content.
+func matchListItemMarker(tokens []*tokenizer.Token) (*listItemMarker, bool) {
+	if len(tokens) < 3 {
+		return nil, false
+	}
+
+	var marker *listItemMarker
+	switch {
+	case tokens[0].Type == tokenizer.Dash || tokens[0].Type == tokenizer.Asterisk || (tokens[0].Type == tokenizer.Text && tokens[0].Value == "+"):
+		if tokens[1].Type != tokenizer.Space {
+			return nil, false
+		}
+		marker = &listItemMarker{
+			kind:   unorderedListKind,
+			symbol: tokens[0].Value,
+			size:   2,
+		}
+		if len(tokens) > 6 && tokens[2].Type == tokenizer.LeftSquareBracket && tokens[4].Type == tokenizer.RightSquareBracket && tokens[5].Type == tokenizer.Space {
+			if tokens[3].Type == tokenizer.Space {
+				marker.kind, marker.size = taskListKind, 6
+			} else if tokens[3].Type == tokenizer.Text && (tokens[3].Value == "x" || tokens[3].Value == "X") {
+				marker.kind, marker.size, marker.complete = taskListKind, 6, true
+			}
+		}
+	case tokens[0].Type == tokenizer.Number:
+		if (tokens[1].Type != tokenizer.Text || tokens[1].Value != ".") && tokens[1].Type != tokenizer.RightParenthesis {
+			return nil, false
+		}
+		if tokens[2].Type != tokenizer.Space {
+			return nil, false
+		}
+		number, err := strconv.Atoi(tokens[0].Value)
+		if err != nil {
+			return nil, false
+		}
+		marker = &listItemMarker{
+			kind:   orderedListKind,
+			symbol: tokens[1].Value,
+			number: number,
+			size:   3,
+		}
+	default:
+		return nil, false
+	}
+
+	if len(tokens) <= marker.size || tokens[marker.size].Type == tokenizer.Newline {
+		return nil, false
+	}
+	return marker, true
+}
+
+// matchList matches the items of a list of the kind with the same symbol, and the more indented items after them.
+func matchList(tokens []*tokenizer.Token, kind listKind) (int, bool) {
+	if len(tokens) == 0 {
+		return 0, false
+	}
+	firstLine := nextListLine(tokens)
+	first, ok := matchListItemMarker(firstLine.contentTokens)
+	if firstLine.indent != 0 || !ok || first.kind != kind {
+		return 0, false
+	}
+
+	cursor := firstLine.size
+	for cursor < len(tokens) {
+		line := nextListLine(tokens[cursor:])
+		marker, ok := matchListItemMarker(line.contentTokens)
+		if !ok {
+			break
+		}
+		if line.indent == 0 && (marker.kind != kind || marker.symbol != first.symbol) {
+			break
+		}
+		cursor += line.size
+	}
+	return cursor, true
+}
+
+func parseList(parser *Parser, tokens []*tokenizer.Token, kind listKind) (ast.Node, error) {
+	size, ok := matchList(tokens, kind)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	first, _ := matchListItemMarker(nextListLine(tokens).contentTokens)
+	items, err := parseListItems(parser, tokens[:size])
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case orderedListKind:
+		return &ast.OrderedList{
+			Start:    first.number,
+			Children: items,
+		}, nil
+	case taskListKind:
+		return &ast.TaskList{
+			Symbol:   first.symbol,
+			Children: items,
+		}, nil
+	default:
+		return &ast.UnorderedList{
+			Symbol:   first.symbol,
+			Children: items,
+		}, nil
+	}
+}
+
+// parseListItems parses the matched lines of a list, where the indented lines after an item are its sublists.
+func parseListItems(parser *Parser, tokens []*tokenizer.Token) ([]ast.Node, error) {
+	items := []ast.Node{}
+	var prevItem ast.Node
+	for len(tokens) > 0 {
+		line := nextListLine(tokens)
+		marker, ok := matchListItemMarker(line.contentTokens)
+		if !ok {
+			return nil, errors.New("not matched")
+		}
+		tokens = tokens[line.size:]
+
+		// The sublist lines are dedented by the indentation of the first one,
+		// and the less indented ones are taken as its siblings.
+		sublistTokens := []*tokenizer.Token{}
+		sublistIndent := 0
+		for len(tokens) > 0 {
+			sublistLine := nextListLine(tokens)
+			if sublistLine.indent == 0 {
+				break
+			}
+			if sublistIndent == 0 {
+				sublistIndent = sublistLine.indent
+			}
+			sublistTokens = append(sublistTokens, tokens[min(sublistLine.indent, sublistIndent):sublistLine.size]...)
+			tokens = tokens[sublistLine.size:]
+		}
+
+		children, err := parser.ParseInline(line.contentTokens[marker.size:])
+		if err != nil {
+			return nil, err
+		}
+		sublists, err := parseSublists(parser, sublistTokens)
+		if err != nil {
+			return nil, err
+		}
+		item := &ast.ListItem{
+			Complete: marker.complete,
+			Children: children,
+			Sublists: sublists,
+		}
+		if prevItem != nil {
+			prevItem.SetNextSibling(item)
+			item.SetPrevSibling(prevItem)
+		}
+		prevItem = item
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func parseSublists(parser *Parser, tokens []*tokenizer.Token) ([]ast.Node, error) {
+	sublists := []ast.Node{}
+	var prevSublist ast.Node
+	for len(tokens) > 0 {
+		matched := false
+		for _, listParser := range listParsers {
+			size, ok := listParser.Match(tokens)
+			if !ok {
+				continue
+			}
+			sublist, err := listParser.ParseWith(parser, tokens)
+			if err != nil {
+				return nil, err
+			}
+			if prevSublist != nil {
+				prevSublist.SetNextSibling(sublist)
+				sublist.SetPrevSibling(prevSublist)
+			}
+			prevSublist = sublist
+			sublists = append(sublists, sublist)
+			tokens = tokens[size:]
+			matched = true
+			break
+		}
+		if !matched {
+			return nil, errors.New("not matched")
+		}
+	}
+	return sublists, nil
+}
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type MathParser struct{}
+
+func NewMathParser() *MathParser {
+	return &MathParser{}
+}
+
+func (*MathParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	size, ok := matchDelimitedInline(tokens, ast.MathDelimiter)
+	if !ok {
+		return 0, false
+	}
+	// A digit after the closing delimiter is a price, e.g. $5 or $10.
+	if size < len(tokens) && tokens[size].Type == tokenizer.Number {
+		return 0, false
+	}
+	return size, true
+}
+
+func (p *MathParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	contentTokens := tokens[len(ast.MathDelimiter) : size-len(ast.MathDelimiter)]
+	return &ast.Math{
+		Content: tokenizer.Stringify(contentTokens),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type MathBlockParser struct{}
+
+func NewMathBlockParser() *MathBlockParser {
+	return &MathBlockParser{}
+}
+
+// Match matches the content between the delimiters, which may span lines, when the closing delimiter ends a line.
+func (*MathBlockParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	contentEnd, ok := matchMathBlock(tokens)
+	if !ok {
+		return 0, false
+	}
+
+	// The newline after the closing delimiter belongs to the block, as in the code blocks.
+	size := contentEnd + len(ast.MathBlockDelimiter)
+	if size < len(tokens) {
+		size++
+	}
+	return size, true
+}
+
+func (p *MathBlockParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	contentEnd, _ := matchMathBlock(tokens)
+	return &ast.MathBlock{
+		Content: tokenizer.Stringify(tokens[len(ast.MathBlockDelimiter):contentEnd]),
+	}, nil
+}
+
+// matchMathBlock returns the start of the closing delimiter.
+func matchMathBlock(tokens []*tokenizer.Token) (int, bool) {
+	if !matchDelimiter(tokens, ast.MathBlockDelimiter) {
+		return 0, false
+	}
+
+	contentStart := len(ast.MathBlockDelimiter)
+	for cursor := contentStart; cursor < len(tokens); cursor++ {
+		if !matchDelimiter(tokens[cursor:], ast.MathBlockDelimiter) {
+			continue
+		}
+		closingEnd := cursor + len(ast.MathBlockDelimiter)
+		if closingEnd < len(tokens) && tokens[closingEnd].Type != tokenizer.Newline {
+			return 0, false
+		}
+		if strings.TrimSpace(tokenizer.Stringify(tokens[contentStart:cursor])) == "" {
+			return 0, false
+		}
+		return cursor, true
+	}
+	return 0, false
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestMathParser(t *testing.T) {
+	tests := []struct {
+		text string
+		math ast.Node
+	}{
+		{
+			text: "$x",
+			math: nil,
+		},
+		{
+			text: "$$x$$",
+			math: nil,
+		},
+		{
+			text: "$5 and $10",
+			math: nil,
+		},
+		{
+			text: "$5,$10",
+			math: nil,
+		},
+		{
+			text: "$e^{i\\pi} + 1 = 0$ holds",
+			math: &ast.Math{
+				Content: "e^{i\\pi} + 1 = 0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewMathParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.math}), StringifyNodes([]ast.Node{node}))
+	}
+}
+
+func TestMathBlockParser(t *testing.T) {
+	tests := []struct {
+		text      string
+		mathBlock ast.Node
+	}{
+		{
+			text:      "$$\n$$",
+			mathBlock: nil,
+		},
+		{
+			text:      "$$x$$ is inline",
+			mathBlock: nil,
+		},
+		{
+			text: "$$x^2$$",
+			mathBlock: &ast.MathBlock{
+				Content: "x^2",
+			},
+		},
+		{
+			text: "$$\n\\sum_{i=1}^n i\n$$\nafter",
+			mathBlock: &ast.MathBlock{
+				Content: "\n\\sum_{i=1}^n i\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewMathBlockParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.mathBlock}), StringifyNodes([]ast.Node{node}))
+	}
+}
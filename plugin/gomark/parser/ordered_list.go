@@ -0,0 +1,24 @@
+package parser
+
+import (
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type OrderedListParser struct{}
+
+func NewOrderedListParser() *OrderedListParser {
+	return &OrderedListParser{}
+}
+
+func (*OrderedListParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	return matchList(tokens, orderedListKind)
+}
+
+func (p *OrderedListParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (*OrderedListParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
+	return parseList(parser, tokens, orderedListKind)
+}
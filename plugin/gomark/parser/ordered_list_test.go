@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestOrderedListParser(t *testing.T) {
+	tests := []struct {
+		text string
+		list ast.Node
+	}{
+		{
+			text: "1.Hello",
+			list: nil,
+		},
+		{
+			text: "1.5 Hello",
+			list: nil,
+		},
+		{
+			text: "1. Hello\n2. world",
+			list: &ast.OrderedList{
+				Start: 1,
+				Children: []ast.Node{
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello",
+							},
+						},
+					},
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "world",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "3) Hello #tag\n   - nested\n4. new list",
+			list: &ast.OrderedList{
+				Start: 3,
+				Children: []ast.Node{
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello ",
+							},
+							&ast.Tag{
+								Content: "tag",
+							},
+						},
+						Sublists: []ast.Node{
+							&ast.UnorderedList{
+								Symbol: "-",
+								Children: []ast.Node{
+									&ast.ListItem{
+										Children: []ast.Node{
+											&ast.Text{
+												Content: "nested",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewOrderedListParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.list}), StringifyNodes([]ast.Node{node}))
+	}
+}
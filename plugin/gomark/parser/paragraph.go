@@ -33,13 +33,17 @@ func (*ParagraphParser) Match(tokens []*tokenizer.Token) (int, bool) {
 }
 
 func (p *ParagraphParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (p *ParagraphParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
 	size, ok := p.Match(tokens)
 	if size == 0 || !ok {
 		return nil, errors.New("not matched")
 	}
 
 	contentTokens := tokens[:size]
-	children, err := ParseInline(contentTokens)
+	children, err := parser.ParseInline(contentTokens)
 	if err != nil {
 		return nil, err
 	}
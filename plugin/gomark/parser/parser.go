@@ -2,14 +2,25 @@ package parser
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 
 	"github.com/usememos/memos/plugin/gomark/ast"
 	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
 )
 
+// Context is the configuration of a Parser, with its block and inline parsers registered by name.
+// The parsers are tried from the highest priority, and in the order of their registration with the same priority.
 type Context struct {
-	BlockParsers  []BlockParser
-	InlineParsers []InlineParser
+	BlockParsers  []*Registration
+	InlineParsers []*Registration
+}
+
+// Registration is a parser registered in a context.
+type Registration struct {
+	Name     string
+	Priority int
+	Parser   BaseParser
 }
 
 type BaseParser interface {
@@ -25,82 +36,230 @@ type BlockParser interface {
 	BaseParser
 }
 
-var defaultBlockParsers = []BlockParser{
-	NewCodeBlockParser(),
-	NewHorizontalRuleParser(),
-	NewHeadingParser(),
-	NewBlockquoteParser(),
-	NewParagraphParser(),
-	NewLineBreakParser(),
+// NestedParser is a parser of the nodes with nested content, e.g. the paragraphs with their inlines.
+// The Parser running it passes itself, so the nested content is parsed with the same parsers.
+type NestedParser interface {
+	BaseParser
+	ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error)
+}
+
+// The default parsers are registered with the priorities in steps of 100, so the extensions can be put between them.
+var defaultBlockParsers = []*Registration{
+	{Name: "diagram", Priority: 1400, Parser: NewDiagramParser()},
+	{Name: "code_block", Priority: 1300, Parser: NewCodeBlockParser()},
+	{Name: "math_block", Priority: 1200, Parser: NewMathBlockParser()},
+	{Name: "fold_block", Priority: 1100, Parser: NewFoldBlockParser()},
+	{Name: "horizontal_rule", Priority: 1000, Parser: NewHorizontalRuleParser()},
+	{Name: "heading", Priority: 900, Parser: NewHeadingParser()},
+	{Name: "blockquote", Priority: 800, Parser: NewBlockquoteParser()},
+	{Name: "table", Priority: 700, Parser: NewTableParser()},
+	{Name: "footnote_definition", Priority: 600, Parser: NewFootnoteDefinitionParser()},
+	{Name: "task_list", Priority: 500, Parser: NewTaskListParser()},
+	{Name: "unordered_list", Priority: 400, Parser: NewUnorderedListParser()},
+	{Name: "ordered_list", Priority: 300, Parser: NewOrderedListParser()},
+	{Name: "paragraph", Priority: 200, Parser: NewParagraphParser()},
+	{Name: "line_break", Priority: 100, Parser: NewLineBreakParser()},
+}
+
+var defaultInlineParsers = []*Registration{
+	{Name: "bold_italic", Priority: 1700, Parser: NewBoldItalicParser()},
+	{Name: "image", Priority: 1600, Parser: NewImageParser()},
+	{Name: "footnote_reference", Priority: 1500, Parser: NewFootnoteReferenceParser()},
+	{Name: "wiki_link", Priority: 1400, Parser: NewWikiLinkParser()},
+	{Name: "link", Priority: 1300, Parser: NewLinkParser()},
+	{Name: "bold", Priority: 1200, Parser: NewBoldParser()},
+	{Name: "italic", Priority: 1100, Parser: NewItalicParser()},
+	{Name: "code", Priority: 1000, Parser: NewCodeParser()},
+	{Name: "tag", Priority: 900, Parser: NewTagParser()},
+	{Name: "strikethrough", Priority: 800, Parser: NewStrikethroughParser()},
+	{Name: "subscript", Priority: 700, Parser: NewSubscriptParser()},
+	{Name: "superscript", Priority: 600, Parser: NewSuperscriptParser()},
+	{Name: "spoiler", Priority: 500, Parser: NewSpoilerParser()},
+	{Name: "highlight", Priority: 400, Parser: NewHighlightParser()},
+	{Name: "math", Priority: 300, Parser: NewMathParser()},
+	{Name: "line_break", Priority: 200, Parser: NewLineBreakParser()},
+	{Name: "text", Priority: 100, Parser: NewTextParser()},
+}
+
+var defaultParser = NewParser(NewContext())
+
+// NewContext creates a new Context with the default parsers.
+func NewContext() *Context {
+	return &Context{
+		BlockParsers:  cloneRegistrations(defaultBlockParsers),
+		InlineParsers: cloneRegistrations(defaultInlineParsers),
+	}
+}
+
+// Clone returns a copy of the context, which can be changed without affecting the original one.
+func (c *Context) Clone() *Context {
+	return &Context{
+		BlockParsers:  cloneRegistrations(c.BlockParsers),
+		InlineParsers: cloneRegistrations(c.InlineParsers),
+	}
+}
+
+// RegisterBlockParser registers a block parser with the priority, replacing the one with the same name.
+func (c *Context) RegisterBlockParser(name string, priority int, parser BlockParser) {
+	c.BlockParsers = register(c.BlockParsers, &Registration{Name: name, Priority: priority, Parser: parser})
+}
+
+// RegisterInlineParser registers an inline parser with the priority, replacing the one with the same name.
+func (c *Context) RegisterInlineParser(name string, priority int, parser InlineParser) {
+	c.InlineParsers = register(c.InlineParsers, &Registration{Name: name, Priority: priority, Parser: parser})
+}
+
+// ReplaceBlockParser replaces the parser of a registered block parser, keeping its priority.
+func (c *Context) ReplaceBlockParser(name string, parser BlockParser) error {
+	return replace(c.BlockParsers, name, parser)
+}
+
+// ReplaceInlineParser replaces the parser of a registered inline parser, keeping its priority.
+func (c *Context) ReplaceInlineParser(name string, parser InlineParser) error {
+	return replace(c.InlineParsers, name, parser)
+}
+
+// DisableBlockParser removes the block parser with the name, if it is registered.
+func (c *Context) DisableBlockParser(name string) {
+	c.BlockParsers = disable(c.BlockParsers, name)
+}
+
+// DisableInlineParser removes the inline parser with the name, if it is registered.
+func (c *Context) DisableInlineParser(name string) {
+	c.InlineParsers = disable(c.InlineParsers, name)
+}
+
+func cloneRegistrations(registrations []*Registration) []*Registration {
+	clones := []*Registration{}
+	for _, registration := range registrations {
+		clone := *registration
+		clones = append(clones, &clone)
+	}
+	return clones
+}
+
+func register(registrations []*Registration, registration *Registration) []*Registration {
+	registrations = append(disable(registrations, registration.Name), registration)
+	sort.SliceStable(registrations, func(i, j int) bool {
+		return registrations[i].Priority > registrations[j].Priority
+	})
+	return registrations
+}
+
+func replace(registrations []*Registration, name string, parser BaseParser) error {
+	for _, registration := range registrations {
+		if registration.Name == name {
+			registration.Parser = parser
+			return nil
+		}
+	}
+	return fmt.Errorf("parser %q is not registered", name)
 }
 
+func disable(registrations []*Registration, name string) []*Registration {
+	kept := []*Registration{}
+	for _, registration := range registrations {
+		if registration.Name != name {
+			kept = append(kept, registration)
+		}
+	}
+	return kept
+}
+
+// Parser parses the tokens with the parsers of its context.
+type Parser struct {
+	blockParsers  []BaseParser
+	inlineParsers []BaseParser
+}
+
+// NewParser creates a new Parser with the parsers of the context.
+// The later changes to the context do not affect the parser.
+func NewParser(context *Context) *Parser {
+	p := &Parser{}
+	for _, registration := range context.BlockParsers {
+		p.blockParsers = append(p.blockParsers, registration.Parser)
+	}
+	for _, registration := range context.InlineParsers {
+		p.inlineParsers = append(p.inlineParsers, registration.Parser)
+	}
+	return p
+}
+
+// Parse parses the tokens to blocks with the default parsers.
 func Parse(tokens []*tokenizer.Token) ([]ast.Node, error) {
+	return defaultParser.Parse(tokens)
+}
+
+// ParseInline parses the tokens to inlines with the default parsers.
+func ParseInline(tokens []*tokenizer.Token) ([]ast.Node, error) {
+	return defaultParser.ParseInline(tokens)
+}
+
+func (p *Parser) Parse(tokens []*tokenizer.Token) ([]ast.Node, error) {
 	nodes := []ast.Node{}
 	var prevNode ast.Node
 	for len(tokens) > 0 {
-		for _, blockParser := range defaultBlockParsers {
-			size, matched := blockParser.Match(tokens)
-			if matched {
-				node, err := blockParser.Parse(tokens)
-				if err != nil {
-					return nil, errors.New("parse error")
-				}
-
-				tokens = tokens[size:]
-				if prevNode != nil {
-					prevNode.SetNextSibling(node)
-					node.SetPrevSibling(prevNode)
-				}
-				prevNode = node
-				nodes = append(nodes, node)
-				break
-			}
+		size, node, err := p.parseNext(p.blockParsers, tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = tokens[size:]
+		if prevNode != nil {
+			prevNode.SetNextSibling(node)
+			node.SetPrevSibling(prevNode)
 		}
+		prevNode = node
+		nodes = append(nodes, node)
 	}
 	return nodes, nil
 }
 
-var defaultInlineParsers = []InlineParser{
-	NewBoldItalicParser(),
-	NewImageParser(),
-	NewLinkParser(),
-	NewBoldParser(),
-	NewItalicParser(),
-	NewCodeParser(),
-	NewTagParser(),
-	NewStrikethroughParser(),
-	NewLineBreakParser(),
-	NewTextParser(),
-}
-
-func ParseInline(tokens []*tokenizer.Token) ([]ast.Node, error) {
+func (p *Parser) ParseInline(tokens []*tokenizer.Token) ([]ast.Node, error) {
 	nodes := []ast.Node{}
 	var prevNode ast.Node
 	for len(tokens) > 0 {
-		for _, inlineParser := range defaultInlineParsers {
-			size, matched := inlineParser.Match(tokens)
-			if matched {
-				node, err := inlineParser.Parse(tokens)
-				if err != nil {
-					return nil, errors.New("parse error")
-				}
-
-				tokens = tokens[size:]
-				if prevNode != nil {
-					// Merge text nodes if possible.
-					if prevNode.Type() == ast.NodeTypeText && node.Type() == ast.NodeTypeText {
-						prevNode.(*ast.Text).Content += node.(*ast.Text).Content
-						break
-					}
-
-					prevNode.SetNextSibling(node)
-					node.SetPrevSibling(prevNode)
-				}
-				nodes = append(nodes, node)
-				prevNode = node
-				break
+		size, node, err := p.parseNext(p.inlineParsers, tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = tokens[size:]
+		if prevNode != nil {
+			// Merge text nodes if possible.
+			if prevNode.Type() == ast.NodeTypeText && node.Type() == ast.NodeTypeText {
+				prevNode.(*ast.Text).Content += node.(*ast.Text).Content
+				continue
 			}
+
+			prevNode.SetNextSibling(node)
+			node.SetPrevSibling(prevNode)
 		}
+		nodes = append(nodes, node)
+		prevNode = node
 	}
 	return nodes, nil
 }
+
+// parseNext parses the leading tokens with the first matched parser, and returns the size of them with the node.
+func (p *Parser) parseNext(parsers []BaseParser, tokens []*tokenizer.Token) (int, ast.Node, error) {
+	for _, parser := range parsers {
+		size, matched := parser.Match(tokens)
+		if !matched || size == 0 {
+			continue
+		}
+
+		var node ast.Node
+		var err error
+		if nestedParser, ok := parser.(NestedParser); ok {
+			node, err = nestedParser.ParseWith(p, tokens)
+		} else {
+			node, err = parser.Parse(tokens)
+		}
+		if err != nil {
+			return 0, nil, errors.New("parse error")
+		}
+		return size, node, nil
+	}
+	return 0, nil, errors.New("not matched")
+}
@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -114,6 +116,56 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			text: "Todo:\n- [ ] Hello\n1. world\n\n- done",
+			nodes: []ast.Node{
+				&ast.Paragraph{
+					Children: []ast.Node{
+						&ast.Text{
+							Content: "Todo:",
+						},
+						&ast.LineBreak{},
+					},
+				},
+				&ast.TaskList{
+					Symbol: "-",
+					Children: []ast.Node{
+						&ast.ListItem{
+							Children: []ast.Node{
+								&ast.Text{
+									Content: "Hello",
+								},
+							},
+						},
+					},
+				},
+				&ast.OrderedList{
+					Start: 1,
+					Children: []ast.Node{
+						&ast.ListItem{
+							Children: []ast.Node{
+								&ast.Text{
+									Content: "world",
+								},
+							},
+						},
+					},
+				},
+				&ast.LineBreak{},
+				&ast.UnorderedList{
+					Symbol: "-",
+					Children: []ast.Node{
+						&ast.ListItem{
+							Children: []ast.Node{
+								&ast.Text{
+									Content: "done",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -123,6 +175,161 @@ func TestParser(t *testing.T) {
 	}
 }
 
+type mention struct {
+	ast.BaseInline
+
+	Name string
+}
+
+var nodeTypeMention = ast.NewNodeType("Mention")
+
+func (*mention) Type() ast.NodeType {
+	return nodeTypeMention
+}
+
+func (n *mention) String() string {
+	return n.Type().String() + " " + n.Name
+}
+
+// mentionParser parses the mentions of users, e.g. @alice.
+type mentionParser struct{}
+
+func (*mentionParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	if len(tokens) == 0 || tokens[0].Type != tokenizer.Text || len(tokens[0].Value) < 2 || !strings.HasPrefix(tokens[0].Value, "@") {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (p *mentionParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+	return &mention{
+		Name: tokens[0].Value[1:],
+	}, nil
+}
+
+func TestParserContext(t *testing.T) {
+	tests := []struct {
+		text    string
+		context func() *Context
+		nodes   []ast.Node
+	}{
+		{
+			text: "Hi @alice #memos",
+			context: func() *Context {
+				context := NewContext()
+				context.RegisterInlineParser("mention", 950, &mentionParser{})
+				return context
+			},
+			nodes: []ast.Node{
+				&ast.Paragraph{
+					Children: []ast.Node{
+						&ast.Text{
+							Content: "Hi ",
+						},
+						&mention{
+							Name: "alice",
+						},
+						&ast.Text{
+							Content: " ",
+						},
+						&ast.Tag{
+							Content: "memos",
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "- @bob",
+			context: func() *Context {
+				context := NewContext()
+				context.RegisterInlineParser("mention", 950, &mentionParser{})
+				return context
+			},
+			nodes: []ast.Node{
+				&ast.UnorderedList{
+					Symbol: "-",
+					Children: []ast.Node{
+						&ast.ListItem{
+							Children: []ast.Node{
+								&mention{
+									Name: "bob",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "Hello #memos",
+			context: func() *Context {
+				context := NewContext()
+				context.DisableInlineParser("tag")
+				return context
+			},
+			nodes: []ast.Node{
+				&ast.Paragraph{
+					Children: []ast.Node{
+						&ast.Text{
+							Content: "Hello #memos",
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "```mermaid\ngraph TD\n```",
+			context: func() *Context {
+				context := NewContext()
+				require.NoError(t, context.ReplaceBlockParser("diagram", NewCodeBlockParser()))
+				return context
+			},
+			nodes: []ast.Node{
+				&ast.CodeBlock{
+					Language: "mermaid",
+					Content:  "graph TD",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		nodes, err := NewParser(test.context()).Parse(tokens)
+		require.NoError(t, err)
+		require.Equal(t, StringifyNodes(test.nodes), StringifyNodes(nodes))
+	}
+
+	// The changes to a context do not affect the default parsers.
+	nodes, err := Parse(tokenizer.Tokenize("Hi @alice #memos"))
+	require.NoError(t, err)
+	require.Equal(t, StringifyNodes([]ast.Node{
+		&ast.Paragraph{
+			Children: []ast.Node{
+				&ast.Text{
+					Content: "Hi @alice ",
+				},
+				&ast.Tag{
+					Content: "memos",
+				},
+			},
+		},
+	}), StringifyNodes(nodes))
+
+	// The disabled parsers leave the tokens unmatched.
+	context := NewContext()
+	context.DisableInlineParser("text")
+	_, err = NewParser(context).Parse(tokenizer.Tokenize("Hello"))
+	require.Error(t, err)
+
+	require.Error(t, NewContext().ReplaceInlineParser("mention", &mentionParser{}))
+}
+
 func StringifyNodes(nodes []ast.Node) string {
 	var result string
 	for _, node := range nodes {
@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type SpoilerParser struct{}
+
+func NewSpoilerParser() *SpoilerParser {
+	return &SpoilerParser{}
+}
+
+func (*SpoilerParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	return matchDelimitedInline(tokens, ast.SpoilerDelimiter)
+}
+
+func (p *SpoilerParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	contentTokens := tokens[len(ast.SpoilerDelimiter) : size-len(ast.SpoilerDelimiter)]
+	return &ast.Spoiler{
+		Content: tokenizer.Stringify(contentTokens),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestSpoilerParser(t *testing.T) {
+	tests := []struct {
+		text    string
+		spoiler ast.Node
+	}{
+		{
+			text:    "||Hello",
+			spoiler: nil,
+		},
+		{
+			text:    "|| Hello||",
+			spoiler: nil,
+		},
+		{
+			text:    "||Hello\nworld||",
+			spoiler: nil,
+		},
+		{
+			text: "||Hello *world*|| again",
+			spoiler: &ast.Spoiler{
+				Content: "Hello *world*",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewSpoilerParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.spoiler}), StringifyNodes([]ast.Node{node}))
+	}
+}
@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type SubscriptParser struct{}
+
+func NewSubscriptParser() *SubscriptParser {
+	return &SubscriptParser{}
+}
+
+func (*SubscriptParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	return matchDelimitedInline(tokens, ast.SubscriptDelimiter)
+}
+
+func (p *SubscriptParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	contentTokens := tokens[len(ast.SubscriptDelimiter) : size-len(ast.SubscriptDelimiter)]
+	return &ast.Subscript{
+		Content: tokenizer.Stringify(contentTokens),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestSubscriptParser(t *testing.T) {
+	tests := []struct {
+		text      string
+		subscript ast.Node
+	}{
+		{
+			text:      "~Hello",
+			subscript: nil,
+		},
+		{
+			text:      "~~Hello~~",
+			subscript: nil,
+		},
+		{
+			text: "~2~O",
+			subscript: &ast.Subscript{
+				Content: "2",
+			},
+		},
+		{
+			text: "~Hello world~",
+			subscript: &ast.Subscript{
+				Content: "Hello world",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewSubscriptParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.subscript}), StringifyNodes([]ast.Node{node}))
+	}
+}
@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type SuperscriptParser struct{}
+
+func NewSuperscriptParser() *SuperscriptParser {
+	return &SuperscriptParser{}
+}
+
+func (*SuperscriptParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	return matchDelimitedInline(tokens, ast.SuperscriptDelimiter)
+}
+
+func (p *SuperscriptParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	contentTokens := tokens[len(ast.SuperscriptDelimiter) : size-len(ast.SuperscriptDelimiter)]
+	return &ast.Superscript{
+		Content: tokenizer.Stringify(contentTokens),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestSuperscriptParser(t *testing.T) {
+	tests := []struct {
+		text        string
+		superscript ast.Node
+	}{
+		{
+			text:        "^Hello",
+			superscript: nil,
+		},
+		{
+			text:        "^^Hello^^",
+			superscript: nil,
+		},
+		{
+			text: "^10^th",
+			superscript: &ast.Superscript{
+				Content: "10",
+			},
+		},
+		{
+			text:        "^Hello world ^",
+			superscript: nil,
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewSuperscriptParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.superscript}), StringifyNodes([]ast.Node{node}))
+	}
+}
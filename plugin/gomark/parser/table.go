@@ -0,0 +1,178 @@
+package parser
+
+import (
+	"errors"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type TableParser struct{}
+
+func NewTableParser() *TableParser {
+	return &TableParser{}
+}
+
+// Match matches a header row and an alignment row with the same number of cells, and the rows after them.
+func (*TableParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	headerSize, header, ok := matchTableRow(tokens)
+	if !ok {
+		return 0, false
+	}
+	alignmentSize, alignmentRow, ok := matchTableRow(tokens[headerSize:])
+	if !ok || len(alignmentRow) != len(header) {
+		return 0, false
+	}
+	for _, cell := range alignmentRow {
+		if _, ok := matchTableAlignment(cell); !ok {
+			return 0, false
+		}
+	}
+
+	cursor := headerSize + alignmentSize
+	for cursor < len(tokens) {
+		rowSize, _, ok := matchTableRow(tokens[cursor:])
+		if !ok {
+			break
+		}
+		cursor += rowSize
+	}
+	return cursor, true
+}
+
+func (p *TableParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (p *TableParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	headerSize, headerRow, _ := matchTableRow(tokens)
+	alignmentSize, alignmentRow, _ := matchTableRow(tokens[headerSize:])
+	table := &ast.Table{}
+	for i, cellTokens := range headerRow {
+		cell, err := parseTableCell(parser, cellTokens)
+		if err != nil {
+			return nil, err
+		}
+		alignment, _ := matchTableAlignment(alignmentRow[i])
+		table.Header = append(table.Header, cell)
+		table.Alignments = append(table.Alignments, alignment)
+	}
+
+	for cursor := headerSize + alignmentSize; cursor < size; {
+		rowSize, row, _ := matchTableRow(tokens[cursor:size])
+		cursor += rowSize
+
+		// The rows are cut or filled with empty cells to the number of the header cells.
+		cells := []*ast.TableCell{}
+		for i := range table.Header {
+			if i >= len(row) {
+				cells = append(cells, &ast.TableCell{})
+				continue
+			}
+			cell, err := parseTableCell(parser, row[i])
+			if err != nil {
+				return nil, err
+			}
+			cells = append(cells, cell)
+		}
+		table.Rows = append(table.Rows, cells)
+	}
+	return table, nil
+}
+
+// matchTableRow matches a line with at least one pipe, and returns its size with the newline and the tokens of its cells.
+// The leading and trailing pipes are optional, and an escaped pipe is a text in the cell.
+func matchTableRow(tokens []*tokenizer.Token) (int, [][]*tokenizer.Token, bool) {
+	size, pipes := 0, 0
+	cells := [][]*tokenizer.Token{}
+	cell := []*tokenizer.Token{}
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		size++
+		if token.Type == tokenizer.Newline {
+			break
+		}
+		switch {
+		case token.Type == tokenizer.Backslash && i+1 < len(tokens) && tokens[i+1].Type == tokenizer.Pipe:
+			cell = append(cell, tokenizer.NewToken(tokenizer.Text, "|"))
+			size++
+			i++
+		case token.Type == tokenizer.Pipe:
+			cells = append(cells, trimTableCell(cell))
+			cell = []*tokenizer.Token{}
+			pipes++
+		default:
+			cell = append(cell, token)
+		}
+	}
+	cells = append(cells, trimTableCell(cell))
+	if pipes == 0 {
+		return 0, nil, false
+	}
+
+	if len(cells[0]) == 0 {
+		cells = cells[1:]
+	}
+	if len(cells) > 0 && len(cells[len(cells)-1]) == 0 {
+		cells = cells[:len(cells)-1]
+	}
+	if len(cells) == 0 {
+		return 0, nil, false
+	}
+	return size, cells, true
+}
+
+func trimTableCell(tokens []*tokenizer.Token) []*tokenizer.Token {
+	for len(tokens) > 0 && tokens[0].Type == tokenizer.Space {
+		tokens = tokens[1:]
+	}
+	for len(tokens) > 0 && tokens[len(tokens)-1].Type == tokenizer.Space {
+		tokens = tokens[:len(tokens)-1]
+	}
+	return tokens
+}
+
+// matchTableAlignment matches an alignment cell, e.g. "---", ":---", "---:" or ":---:".
+func matchTableAlignment(tokens []*tokenizer.Token) (string, bool) {
+	left, right := false, false
+	if len(tokens) > 0 && tokens[0].Type == tokenizer.Text && tokens[0].Value == ":" {
+		left, tokens = true, tokens[1:]
+	}
+	if len(tokens) > 0 && tokens[len(tokens)-1].Type == tokenizer.Text && tokens[len(tokens)-1].Value == ":" {
+		right, tokens = true, tokens[:len(tokens)-1]
+	}
+	if len(tokens) == 0 {
+		return "", false
+	}
+	for _, token := range tokens {
+		if token.Type != tokenizer.Dash {
+			return "", false
+		}
+	}
+
+	switch {
+	case left && right:
+		return "center", true
+	case left:
+		return "left", true
+	case right:
+		return "right", true
+	default:
+		return "", true
+	}
+}
+
+func parseTableCell(parser *Parser, tokens []*tokenizer.Token) (*ast.TableCell, error) {
+	children, err := parser.ParseInline(tokens)
+	if err != nil {
+		return nil, err
+	}
+	return &ast.TableCell{
+		Children: children,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestTableParser(t *testing.T) {
+	tests := []struct {
+		text  string
+		table ast.Node
+	}{
+		{
+			text:  "| Hello | world |",
+			table: nil,
+		},
+		{
+			text:  "| Hello | world |\n| --- |",
+			table: nil,
+		},
+		{
+			text:  "Hello\n---",
+			table: nil,
+		},
+		{
+			text: "| Hello | **world** |\n| :--- | :-: |\n| a \\| b | c |",
+			table: &ast.Table{
+				Header: []*ast.TableCell{
+					{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello",
+							},
+						},
+					},
+					{
+						Children: []ast.Node{
+							&ast.Bold{
+								Symbol:  "*",
+								Content: "world",
+							},
+						},
+					},
+				},
+				Alignments: []string{"left", "center"},
+				Rows: [][]*ast.TableCell{
+					{
+						{
+							Children: []ast.Node{
+								&ast.Text{
+									Content: "a | b",
+								},
+							},
+						},
+						{
+							Children: []ast.Node{
+								&ast.Text{
+									Content: "c",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "Hello | world\n---|--:\none\none | two | three\n\nparagraph",
+			table: &ast.Table{
+				Header: []*ast.TableCell{
+					{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello",
+							},
+						},
+					},
+					{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "world",
+							},
+						},
+					},
+				},
+				Alignments: []string{"", "right"},
+				Rows:       [][]*ast.TableCell{},
+			},
+		},
+		{
+			text: "| #tag | |\n|---|---|\n| 1 |",
+			table: &ast.Table{
+				Header: []*ast.TableCell{
+					{
+						Children: []ast.Node{
+							&ast.Tag{
+								Content: "tag",
+							},
+						},
+					},
+					{},
+				},
+				Alignments: []string{"", ""},
+				Rows: [][]*ast.TableCell{
+					{
+						{
+							Children: []ast.Node{
+								&ast.Text{
+									Content: "1",
+								},
+							},
+						},
+						{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewTableParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.table}), StringifyNodes([]ast.Node{node}))
+	}
+}
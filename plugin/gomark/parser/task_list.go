@@ -0,0 +1,24 @@
+package parser
+
+import (
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type TaskListParser struct{}
+
+func NewTaskListParser() *TaskListParser {
+	return &TaskListParser{}
+}
+
+func (*TaskListParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	return matchList(tokens, taskListKind)
+}
+
+func (p *TaskListParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (*TaskListParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
+	return parseList(parser, tokens, taskListKind)
+}
@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestTaskListParser(t *testing.T) {
+	tests := []struct {
+		text string
+		list ast.Node
+	}{
+		{
+			text: "- [ ]",
+			list: nil,
+		},
+		{
+			text: "- [y] Hello",
+			list: nil,
+		},
+		{
+			text: "- [ ] Hello\n- [x] world\n- [X] done\n- plain",
+			list: &ast.TaskList{
+				Symbol: "-",
+				Children: []ast.Node{
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello",
+							},
+						},
+					},
+					&ast.ListItem{
+						Complete: true,
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "world",
+							},
+						},
+					},
+					&ast.ListItem{
+						Complete: true,
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "done",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "* [x] Hello\n\t* [ ] world",
+			list: &ast.TaskList{
+				Symbol: "*",
+				Children: []ast.Node{
+					&ast.ListItem{
+						Complete: true,
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewTaskListParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.list}), StringifyNodes([]ast.Node{node}))
+	}
+}
@@ -15,12 +15,20 @@ const (
 	Tilde              TokenType = "~"
 	Dash               TokenType = "-"
 	GreaterThan        TokenType = ">"
+	Pipe               TokenType = "|"
+	Backslash          TokenType = "\\"
+	Caret              TokenType = "^"
+	EqualSign          TokenType = "="
+	Percent            TokenType = "%"
+	DollarSign         TokenType = "$"
 	Newline            TokenType = "\n"
 	Space              TokenType = " "
 )
 
 const (
 	Text TokenType = ""
+	// Number is a run of ASCII digits.
+	Number TokenType = "number"
 )
 
 type Token struct {
@@ -63,17 +71,33 @@ func Tokenize(text string) []*Token {
 			tokens = append(tokens, NewToken(Dash, "-"))
 		case '>':
 			tokens = append(tokens, NewToken(GreaterThan, ">"))
+		case '|':
+			tokens = append(tokens, NewToken(Pipe, "|"))
+		case '\\':
+			tokens = append(tokens, NewToken(Backslash, "\\"))
+		case '^':
+			tokens = append(tokens, NewToken(Caret, "^"))
+		case '=':
+			tokens = append(tokens, NewToken(EqualSign, "="))
+		case '%':
+			tokens = append(tokens, NewToken(Percent, "%"))
+		case '$':
+			tokens = append(tokens, NewToken(DollarSign, "$"))
 		case '\n':
 			tokens = append(tokens, NewToken(Newline, "\n"))
 		case ' ':
 			tokens = append(tokens, NewToken(Space, " "))
 		default:
+			tokenType := Text
+			if c >= '0' && c <= '9' {
+				tokenType = Number
+			}
 			var lastToken *Token
 			if len(tokens) > 0 {
 				lastToken = tokens[len(tokens)-1]
 			}
-			if lastToken == nil || lastToken.Type != Text {
-				tokens = append(tokens, NewToken(Text, string(c)))
+			if lastToken == nil || lastToken.Type != tokenType {
+				tokens = append(tokens, NewToken(tokenType, string(c)))
 			} else {
 				lastToken.Value += string(c)
 			}
@@ -70,6 +70,48 @@ func TestTokenize(t *testing.T) {
 				},
 			},
 		},
+		{
+			text: "12. v2",
+			tokens: []*Token{
+				{
+					Type:  Number,
+					Value: "12",
+				},
+				{
+					Type:  Text,
+					Value: ".",
+				},
+				{
+					Type:  Space,
+					Value: " ",
+				},
+				{
+					Type:  Text,
+					Value: "v",
+				},
+				{
+					Type:  Number,
+					Value: "2",
+				},
+			},
+		},
+		{
+			text: `a\|`,
+			tokens: []*Token{
+				{
+					Type:  Text,
+					Value: "a",
+				},
+				{
+					Type:  Backslash,
+					Value: `\`,
+				},
+				{
+					Type:  Pipe,
+					Value: "|",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,24 @@
+package parser
+
+import (
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type UnorderedListParser struct{}
+
+func NewUnorderedListParser() *UnorderedListParser {
+	return &UnorderedListParser{}
+}
+
+func (*UnorderedListParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	return matchList(tokens, unorderedListKind)
+}
+
+func (p *UnorderedListParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	return p.ParseWith(defaultParser, tokens)
+}
+
+func (*UnorderedListParser) ParseWith(parser *Parser, tokens []*tokenizer.Token) (ast.Node, error) {
+	return parseList(parser, tokens, unorderedListKind)
+}
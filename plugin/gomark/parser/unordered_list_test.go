@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestUnorderedListParser(t *testing.T) {
+	tests := []struct {
+		text string
+		list ast.Node
+	}{
+		{
+			text: "-Hello",
+			list: nil,
+		},
+		{
+			text: "- ",
+			list: nil,
+		},
+		{
+			text: "- Hello **world**",
+			list: &ast.UnorderedList{
+				Symbol: "-",
+				Children: []ast.Node{
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello ",
+							},
+							&ast.Bold{
+								Symbol:  "*",
+								Content: "world",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "* Hello\n* world\n- new list",
+			list: &ast.UnorderedList{
+				Symbol: "*",
+				Children: []ast.Node{
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello",
+							},
+						},
+					},
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "world",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			text: "+ Hello\n  + nested\n    1. deeper\n  + [ ] task\n+ world\nparagraph",
+			list: &ast.UnorderedList{
+				Symbol: "+",
+				Children: []ast.Node{
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "Hello",
+							},
+						},
+						Sublists: []ast.Node{
+							&ast.UnorderedList{
+								Symbol: "+",
+								Children: []ast.Node{
+									&ast.ListItem{
+										Children: []ast.Node{
+											&ast.Text{
+												Content: "nested",
+											},
+										},
+										Sublists: []ast.Node{
+											&ast.OrderedList{
+												Start: 1,
+												Children: []ast.Node{
+													&ast.ListItem{
+														Children: []ast.Node{
+															&ast.Text{
+																Content: "deeper",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							&ast.TaskList{
+								Symbol: "+",
+								Children: []ast.Node{
+									&ast.ListItem{
+										Children: []ast.Node{
+											&ast.Text{
+												Content: "task",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&ast.ListItem{
+						Children: []ast.Node{
+							&ast.Text{
+								Content: "world",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewUnorderedListParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.list}), StringifyNodes([]ast.Node{node}))
+	}
+}
@@ -0,0 +1,57 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+type WikiLinkParser struct{}
+
+func NewWikiLinkParser() *WikiLinkParser {
+	return &WikiLinkParser{}
+}
+
+func (*WikiLinkParser) Match(tokens []*tokenizer.Token) (int, bool) {
+	if len(tokens) < 5 {
+		return 0, false
+	}
+	if tokens[0].Type != tokenizer.LeftSquareBracket || tokens[1].Type != tokenizer.LeftSquareBracket {
+		return 0, false
+	}
+	targetTokens := []*tokenizer.Token{}
+	for _, token := range tokens[2:] {
+		if token.Type == tokenizer.Newline || token.Type == tokenizer.LeftSquareBracket {
+			return 0, false
+		}
+		if token.Type == tokenizer.RightSquareBracket {
+			break
+		}
+		targetTokens = append(targetTokens, token)
+	}
+	if strings.TrimSpace(tokenizer.Stringify(targetTokens)) == "" {
+		return 0, false
+	}
+	if len(targetTokens)+4 > len(tokens) {
+		return 0, false
+	}
+	if tokens[2+len(targetTokens)].Type != tokenizer.RightSquareBracket || tokens[3+len(targetTokens)].Type != tokenizer.RightSquareBracket {
+		return 0, false
+	}
+
+	return len(targetTokens) + 4, true
+}
+
+func (p *WikiLinkParser) Parse(tokens []*tokenizer.Token) (ast.Node, error) {
+	size, ok := p.Match(tokens)
+	if size == 0 || !ok {
+		return nil, errors.New("not matched")
+	}
+
+	targetTokens := tokens[2 : size-2]
+	return &ast.WikiLink{
+		Target: strings.TrimSpace(tokenizer.Stringify(targetTokens)),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestWikiLinkParser(t *testing.T) {
+	tests := []struct {
+		text     string
+		wikiLink ast.Node
+	}{
+		{
+			text:     "[[]]",
+			wikiLink: nil,
+		},
+		{
+			text:     "[[memo:123]",
+			wikiLink: nil,
+		},
+		{
+			text:     "[[hello\nworld]]",
+			wikiLink: nil,
+		},
+		{
+			text: "[[memo:123]]",
+			wikiLink: &ast.WikiLink{
+				Target: "memo:123",
+			},
+		},
+		{
+			text: "[[ Hello world ]] 123",
+			wikiLink: &ast.WikiLink{
+				Target: "Hello world",
+			},
+		},
+	}
+	for _, test := range tests {
+		tokens := tokenizer.Tokenize(test.text)
+		node, _ := NewWikiLinkParser().Parse(tokens)
+		require.Equal(t, StringifyNodes([]ast.Node{test.wikiLink}), StringifyNodes([]ast.Node{node}))
+	}
+}
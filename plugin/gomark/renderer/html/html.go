@@ -3,6 +3,7 @@ package html
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/usememos/memos/plugin/gomark/ast"
 )
@@ -15,18 +16,40 @@ type HTMLRenderer struct {
 }
 
 type RendererContext struct {
+	// nodeRenderers render the nodes of their types instead of the built-in rendering.
+	nodeRenderers map[ast.NodeType]NodeRenderer
 }
 
+// NodeRenderer renders a node to the output of the renderer, e.g. a custom node of a parser extension.
+type NodeRenderer func(r *HTMLRenderer, node ast.Node)
+
 // NewHTMLRenderer creates a new HTMLRenderer.
 func NewHTMLRenderer() *HTMLRenderer {
 	return &HTMLRenderer{
-		output:  new(bytes.Buffer),
-		context: &RendererContext{},
+		output: new(bytes.Buffer),
+		context: &RendererContext{
+			nodeRenderers: map[ast.NodeType]NodeRenderer{},
+		},
 	}
 }
 
+// RegisterNodeRenderer registers the renderer of the nodes of the type, replacing the built-in or the registered one.
+func (r *HTMLRenderer) RegisterNodeRenderer(nodeType ast.NodeType, renderer NodeRenderer) {
+	r.context.nodeRenderers[nodeType] = renderer
+}
+
+// WriteString writes the string to the output, so the registered node renderers can write their HTML.
+func (r *HTMLRenderer) WriteString(s string) {
+	r.output.WriteString(s)
+}
+
 // RenderNode renders a single AST node to HTML.
 func (r *HTMLRenderer) RenderNode(node ast.Node) {
+	if renderer, ok := r.context.nodeRenderers[node.Type()]; ok {
+		renderer(r, node)
+		return
+	}
+
 	prevSibling, nextSibling := node.GetPrevSibling(), node.GetNextSibling()
 
 	switch n := node.(type) {
@@ -57,6 +80,78 @@ func (r *HTMLRenderer) RenderNode(node ast.Node) {
 		if prevSibling == nil || prevSibling.Type() != ast.NodeTypeBlockquote {
 			r.output.WriteString("</blockquote>")
 		}
+	case *ast.MathBlock:
+		r.output.WriteString(`<div class="math">`)
+		r.output.WriteString(n.Content)
+		r.output.WriteString("</div>")
+	case *ast.Diagram:
+		// The diagrams are drawn by the scripts of their languages on the client, e.g. mermaid.js.
+		r.output.WriteString(fmt.Sprintf(`<pre class="%s">`, n.Language))
+		r.output.WriteString(n.Content)
+		r.output.WriteString("</pre>")
+	case *ast.FootnoteDefinition:
+		r.output.WriteString(fmt.Sprintf(`<div id="fn-%s"><sup>%s</sup> `, n.Label, n.Label))
+		r.RenderNodes(n.Children)
+		r.output.WriteString(fmt.Sprintf(` <a href="#fnref-%s">↩</a></div>`, n.Label))
+	case *ast.FoldBlock:
+		r.output.WriteString("<details><summary>")
+		r.output.WriteString(strings.TrimSpace(n.Summary))
+		r.output.WriteString("</summary>")
+		r.RenderNodes(n.Children)
+		r.output.WriteString("</details>")
+	case *ast.UnorderedList:
+		r.output.WriteString("<ul>")
+		r.RenderNodes(n.Children)
+		r.output.WriteString("</ul>")
+	case *ast.OrderedList:
+		if n.Start != 1 {
+			r.output.WriteString(fmt.Sprintf(`<ol start="%d">`, n.Start))
+		} else {
+			r.output.WriteString("<ol>")
+		}
+		r.RenderNodes(n.Children)
+		r.output.WriteString("</ol>")
+	case *ast.TaskList:
+		r.output.WriteString("<ul>")
+		for _, child := range n.Children {
+			item, ok := child.(*ast.ListItem)
+			if !ok {
+				continue
+			}
+			r.output.WriteString("<li>")
+			if item.Complete {
+				r.output.WriteString(`<input type="checkbox" checked disabled />`)
+			} else {
+				r.output.WriteString(`<input type="checkbox" disabled />`)
+			}
+			r.RenderNodes(item.Children)
+			r.RenderNodes(item.Sublists)
+			r.output.WriteString("</li>")
+		}
+		r.output.WriteString("</ul>")
+	case *ast.ListItem:
+		r.output.WriteString("<li>")
+		r.RenderNodes(n.Children)
+		r.RenderNodes(n.Sublists)
+		r.output.WriteString("</li>")
+	case *ast.Table:
+		r.output.WriteString("<table><thead><tr>")
+		for i, cell := range n.Header {
+			r.renderTableCell("th", cell, n.Alignments[i])
+		}
+		r.output.WriteString("</tr></thead>")
+		if len(n.Rows) > 0 {
+			r.output.WriteString("<tbody>")
+			for _, row := range n.Rows {
+				r.output.WriteString("<tr>")
+				for i, cell := range row {
+					r.renderTableCell("td", cell, n.Alignments[i])
+				}
+				r.output.WriteString("</tr>")
+			}
+			r.output.WriteString("</tbody>")
+		}
+		r.output.WriteString("</table>")
 	case *ast.BoldItalic:
 		r.output.WriteString("<strong><em>")
 		r.output.WriteString(n.Content)
@@ -90,10 +185,45 @@ func (r *HTMLRenderer) RenderNode(node ast.Node) {
 		r.output.WriteString(`# `)
 		r.output.WriteString(n.Content)
 		r.output.WriteString(`</span>`)
+	case *ast.WikiLink:
+		// The links by title are resolved by the server, so only the memo ids become anchors here.
+		if memoID, ok := strings.CutPrefix(n.Target, "memo:"); ok {
+			r.output.WriteString(`<a href="/m/`)
+			r.output.WriteString(memoID)
+			r.output.WriteString(`">`)
+			r.output.WriteString(n.Target)
+			r.output.WriteString("</a>")
+		} else {
+			r.output.WriteString(`<span>`)
+			r.output.WriteString(n.Target)
+			r.output.WriteString(`</span>`)
+		}
 	case *ast.Strikethrough:
 		r.output.WriteString(`<del>`)
 		r.output.WriteString(n.Content)
 		r.output.WriteString(`</del>`)
+	case *ast.Spoiler:
+		r.output.WriteString(`<span class="spoiler">`)
+		r.output.WriteString(n.Content)
+		r.output.WriteString(`</span>`)
+	case *ast.Superscript:
+		r.output.WriteString(`<sup>`)
+		r.output.WriteString(n.Content)
+		r.output.WriteString(`</sup>`)
+	case *ast.Subscript:
+		r.output.WriteString(`<sub>`)
+		r.output.WriteString(n.Content)
+		r.output.WriteString(`</sub>`)
+	case *ast.Highlight:
+		r.output.WriteString(`<mark>`)
+		r.output.WriteString(n.Content)
+		r.output.WriteString(`</mark>`)
+	case *ast.Math:
+		r.output.WriteString(`<span class="math">`)
+		r.output.WriteString(n.Content)
+		r.output.WriteString(`</span>`)
+	case *ast.FootnoteReference:
+		r.output.WriteString(fmt.Sprintf(`<sup id="fnref-%s"><a href="#fn-%s">%s</a></sup>`, n.Label, n.Label, n.Label))
 	case *ast.Text:
 		r.output.WriteString(n.Content)
 	default:
@@ -101,11 +231,22 @@ func (r *HTMLRenderer) RenderNode(node ast.Node) {
 	}
 }
 
+func (r *HTMLRenderer) renderTableCell(tag string, cell *ast.TableCell, alignment string) {
+	if alignment != "" {
+		r.output.WriteString(fmt.Sprintf(`<%s align="%s">`, tag, alignment))
+	} else {
+		r.output.WriteString(fmt.Sprintf("<%s>", tag))
+	}
+	r.RenderNodes(cell.Children)
+	r.output.WriteString(fmt.Sprintf("</%s>", tag))
+}
+
 // RenderNodes renders a slice of AST nodes to HTML.
 func (r *HTMLRenderer) RenderNodes(nodes []ast.Node) {
 	for _, node := range nodes {
 		prevSibling := node.GetPrevSibling()
-		if prevSibling != nil {
+		// The consecutive blockquotes are rendered together by the first one, unless they have a registered renderer.
+		if _, ok := r.context.nodeRenderers[node.Type()]; prevSibling != nil && !ok {
 			if prevSibling.Type() == node.Type() {
 				if node.Type() == ast.NodeTypeBlockquote {
 					continue
@@ -1,10 +1,12 @@
 package html
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/usememos/memos/plugin/gomark/ast"
 	"github.com/usememos/memos/plugin/gomark/parser"
 	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
 )
@@ -30,6 +32,26 @@ func TestHTMLRenderer(t *testing.T) {
 			text:     "**Hello** world!",
 			expected: `<p><strong>Hello</strong> world!</p>`,
 		},
+		{
+			text:     "See [[memo:12]] and [[Weekly notes]]",
+			expected: `<p>See <a href="/m/12">memo:12</a> and <span>Weekly notes</span></p>`,
+		},
+		{
+			text:     "- Hello\n  2. **world**\n  3. again\n- [x] done\n- [ ] todo",
+			expected: `<ul><li>Hello<ol start="2"><li><strong>world</strong></li><li>again</li></ol></li></ul><ul><li><input type="checkbox" checked disabled />done</li><li><input type="checkbox" disabled />todo</li></ul>`,
+		},
+		{
+			text:     "| Name | Count |\n| --- | ---: |\n| *a* \\| b | 2 |\n| c |",
+			expected: `<table><thead><tr><th>Name</th><th align="right">Count</th></tr></thead><tbody><tr><td><em>a</em> | b</td><td align="right">2</td></tr><tr><td>c</td><td align="right"></td></tr></tbody></table>`,
+		},
+		{
+			text:     "%%% Details\nH~2~O is ==water==, ||E = mc^2^||\n%%%",
+			expected: `<details><summary>Details</summary><p>H<sub>2</sub>O is <mark>water</mark>, <span class="spoiler">E = mc^2^</span></p></details>`,
+		},
+		{
+			text:     "Euler $e^{i\\pi} = -1$[^1]\n$$\nx^2\n$$\n```mermaid\ngraph TD\n```\n[^1]: A *note*",
+			expected: `<p>Euler <span class="math">e^{i\pi} = -1</span><sup id="fnref-1"><a href="#fn-1">1</a></sup><br></p><div class="math">` + "\nx^2\n" + `</div><pre class="mermaid">graph TD</pre><div id="fn-1"><sup>1</sup> A <em>note</em> <a href="#fnref-1">↩</a></div>`,
+		},
 	}
 
 	for _, test := range tests {
@@ -40,3 +62,36 @@ func TestHTMLRenderer(t *testing.T) {
 		require.Equal(t, test.expected, actual)
 	}
 }
+
+type emoji struct {
+	ast.BaseInline
+
+	Name string
+}
+
+var nodeTypeEmoji = ast.NewNodeType("Emoji")
+
+func (*emoji) Type() ast.NodeType {
+	return nodeTypeEmoji
+}
+
+func (n *emoji) String() string {
+	return n.Type().String() + " " + n.Name
+}
+
+func TestHTMLRendererNodeRenderers(t *testing.T) {
+	nodes, err := parser.Parse(tokenizer.Tokenize("Hello #memos"))
+	require.NoError(t, err)
+	paragraph := nodes[0].(*ast.Paragraph)
+	paragraph.Children = append(paragraph.Children, &emoji{Name: "wave"})
+
+	renderer := NewHTMLRenderer()
+	renderer.RegisterNodeRenderer(nodeTypeEmoji, func(r *HTMLRenderer, node ast.Node) {
+		r.WriteString(fmt.Sprintf(`<span class="emoji">%s</span>`, node.(*emoji).Name))
+	})
+	renderer.RegisterNodeRenderer(ast.NodeTypeTag, func(r *HTMLRenderer, node ast.Node) {
+		tag := node.(*ast.Tag)
+		r.WriteString(fmt.Sprintf(`<a href="/explore?tag=%s">#%s</a>`, tag.Content, tag.Content))
+	})
+	require.Equal(t, `<p>Hello <a href="/explore?tag=memos">#memos</a><span class="emoji">wave</span></p>`, renderer.Render(nodes))
+}
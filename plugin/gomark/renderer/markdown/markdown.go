@@ -0,0 +1,220 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/plugin/gomark/ast"
+)
+
+// MarkdownRenderer converts AST back to markdown, so the parsed content can be rewritten.
+// The content written in the canonical form is restored as is.
+// nolint
+type MarkdownRenderer struct {
+	output *bytes.Buffer
+	// indent is the indentation of the nested list items.
+	indent string
+	// inTable escapes the pipes of the texts in the table cells.
+	inTable bool
+}
+
+// NewMarkdownRenderer creates a new MarkdownRenderer.
+func NewMarkdownRenderer() *MarkdownRenderer {
+	return &MarkdownRenderer{
+		output: new(bytes.Buffer),
+	}
+}
+
+// RenderNode renders a single AST node to markdown.
+func (r *MarkdownRenderer) RenderNode(node ast.Node) {
+	switch n := node.(type) {
+	case *ast.LineBreak:
+		r.output.WriteString("\n")
+	case *ast.Paragraph:
+		r.RenderNodes(n.Children)
+	case *ast.CodeBlock:
+		r.output.WriteString("```")
+		r.output.WriteString(n.Language)
+		r.output.WriteString("\n")
+		r.output.WriteString(n.Content)
+		r.output.WriteString("\n```")
+	case *ast.MathBlock:
+		r.renderDelimited(ast.MathBlockDelimiter, n.Content)
+	case *ast.Diagram:
+		r.output.WriteString("```")
+		r.output.WriteString(n.Language)
+		r.output.WriteString("\n")
+		r.output.WriteString(n.Content)
+		r.output.WriteString("\n```")
+	case *ast.FootnoteDefinition:
+		r.output.WriteString(fmt.Sprintf("%s%s]: ", ast.FootnotePrefix, n.Label))
+		r.RenderNodes(n.Children)
+	case *ast.FoldBlock:
+		r.output.WriteString(ast.FoldBlockDelimiter)
+		r.output.WriteString(n.Summary)
+		r.output.WriteString("\n")
+		if len(n.Children) > 0 {
+			r.RenderNodes(n.Children)
+			r.output.WriteString("\n")
+		}
+		r.output.WriteString(ast.FoldBlockDelimiter)
+	case *ast.Heading:
+		r.output.WriteString(strings.Repeat("#", n.Level))
+		r.output.WriteString(" ")
+		r.RenderNodes(n.Children)
+	case *ast.HorizontalRule:
+		r.output.WriteString(strings.Repeat(n.Symbol, 3))
+	case *ast.Blockquote:
+		r.output.WriteString("> ")
+		r.RenderNodes(n.Children)
+	case *ast.UnorderedList:
+		r.renderListItems(n.Children, 0, func(int, *ast.ListItem) string {
+			return n.Symbol + " "
+		})
+	case *ast.OrderedList:
+		r.renderListItems(n.Children, 0, func(i int, _ *ast.ListItem) string {
+			return fmt.Sprintf("%d. ", n.Start+i)
+		})
+	case *ast.TaskList:
+		// The sublists of the tasks are aligned with the checkbox.
+		r.renderListItems(n.Children, len(n.Symbol)+1, func(_ int, item *ast.ListItem) string {
+			if item.Complete {
+				return n.Symbol + " [x] "
+			}
+			return n.Symbol + " [ ] "
+		})
+	case *ast.Table:
+		r.renderTableRow(n.Header)
+		r.output.WriteString("\n|")
+		for _, alignment := range n.Alignments {
+			switch alignment {
+			case "left":
+				r.output.WriteString(" :--- |")
+			case "center":
+				r.output.WriteString(" :---: |")
+			case "right":
+				r.output.WriteString(" ---: |")
+			default:
+				r.output.WriteString(" --- |")
+			}
+		}
+		for _, row := range n.Rows {
+			r.output.WriteString("\n")
+			r.renderTableRow(row)
+		}
+	case *ast.BoldItalic:
+		r.renderDelimited(strings.Repeat(n.Symbol, 3), n.Content)
+	case *ast.Bold:
+		r.renderDelimited(strings.Repeat(n.Symbol, 2), n.Content)
+	case *ast.Italic:
+		r.renderDelimited(n.Symbol, n.Content)
+	case *ast.Code:
+		r.renderDelimited("`", n.Content)
+	case *ast.Link:
+		r.output.WriteString(fmt.Sprintf("[%s](%s)", n.Text, n.URL))
+	case *ast.Image:
+		r.output.WriteString(fmt.Sprintf("![%s](%s)", n.AltText, n.URL))
+	case *ast.Tag:
+		r.output.WriteString("#")
+		r.output.WriteString(n.Content)
+	case *ast.WikiLink:
+		r.output.WriteString(fmt.Sprintf("[[%s]]", n.Target))
+	case *ast.Strikethrough:
+		r.renderDelimited("~~", n.Content)
+	case *ast.Spoiler:
+		r.renderDelimited(ast.SpoilerDelimiter, n.Content)
+	case *ast.Superscript:
+		r.renderDelimited(ast.SuperscriptDelimiter, n.Content)
+	case *ast.Subscript:
+		r.renderDelimited(ast.SubscriptDelimiter, n.Content)
+	case *ast.Highlight:
+		r.renderDelimited(ast.HighlightDelimiter, n.Content)
+	case *ast.Math:
+		r.renderDelimited(ast.MathDelimiter, n.Content)
+	case *ast.FootnoteReference:
+		r.output.WriteString(fmt.Sprintf("%s%s]", ast.FootnotePrefix, n.Label))
+	case *ast.Text:
+		if r.inTable {
+			r.output.WriteString(strings.ReplaceAll(n.Content, "|", `\|`))
+		} else {
+			r.output.WriteString(n.Content)
+		}
+	default:
+		// Handle other block types if needed.
+	}
+}
+
+// RenderNodes renders a slice of AST nodes to markdown.
+func (r *MarkdownRenderer) RenderNodes(nodes []ast.Node) {
+	for _, node := range nodes {
+		r.RenderNode(node)
+		// The blocks ending with their own newline are followed by it, unless they end the content.
+		if node.GetNextSibling() != nil && endsWithNewline(node) {
+			r.output.WriteString("\n")
+		}
+	}
+}
+
+// Render renders the AST to markdown.
+func (r *MarkdownRenderer) Render(astRoot []ast.Node) string {
+	r.RenderNodes(astRoot)
+	return r.output.String()
+}
+
+func (r *MarkdownRenderer) renderDelimited(delimiter, content string) {
+	r.output.WriteString(delimiter)
+	r.output.WriteString(content)
+	r.output.WriteString(delimiter)
+}
+
+// renderListItems renders the items on their lines, with the sublists indented by sublistIndent,
+// or under the item content if it is 0.
+func (r *MarkdownRenderer) renderListItems(items []ast.Node, sublistIndent int, marker func(i int, item *ast.ListItem) string) {
+	for i, child := range items {
+		item, ok := child.(*ast.ListItem)
+		if !ok {
+			continue
+		}
+		if i > 0 {
+			r.output.WriteString("\n")
+		}
+		itemMarker := marker(i, item)
+		r.output.WriteString(r.indent)
+		r.output.WriteString(itemMarker)
+		r.RenderNodes(item.Children)
+
+		indent := r.indent
+		if sublistIndent == 0 {
+			r.indent += strings.Repeat(" ", len(itemMarker))
+		} else {
+			r.indent += strings.Repeat(" ", sublistIndent)
+		}
+		for _, sublist := range item.Sublists {
+			r.output.WriteString("\n")
+			r.RenderNode(sublist)
+		}
+		r.indent = indent
+	}
+}
+
+func (r *MarkdownRenderer) renderTableRow(cells []*ast.TableCell) {
+	r.inTable = true
+	r.output.WriteString("|")
+	for _, cell := range cells {
+		r.output.WriteString(" ")
+		r.RenderNodes(cell.Children)
+		r.output.WriteString(" |")
+	}
+	r.inTable = false
+}
+
+// endsWithNewline returns whether the parser takes the newline after the block as a part of it.
+func endsWithNewline(node ast.Node) bool {
+	switch node.(type) {
+	case *ast.CodeBlock, *ast.Diagram, *ast.MathBlock, *ast.FoldBlock, *ast.UnorderedList, *ast.OrderedList, *ast.TaskList, *ast.Table:
+		return true
+	default:
+		return false
+	}
+}
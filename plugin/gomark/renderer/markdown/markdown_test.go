@@ -0,0 +1,30 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/gomark/parser"
+	"github.com/usememos/memos/plugin/gomark/parser/tokenizer"
+)
+
+func TestMarkdownRenderer(t *testing.T) {
+	tests := []string{
+		"Hello world!",
+		"# Hello **world**\n\n> quoted #tag\n---\n![alt](https://example.com/a.png) and [[memo:1]]",
+		"```go\nfmt.Println(1)\n```\nafter",
+		"%%% Details\n## Heading\nH~2~O is ==water==, ||E = mc^2^||\n%%%\nafter",
+		"%%%\n%%%",
+		"- Hello\n  1. *world*\n  2. again\n     - [x] done\n- [ ] todo\n  - nested\n\n3. three",
+		"| Name | Count |\n| :--- | ---: |\n| a \\| b | `2` |\ntext",
+		"~~strike~~ ~sub~ ^sup^ ==mark== ||spoiler||",
+		"Euler $e^{i\\pi} = -1$[^1]\n$$\nx^2\n$$\n```mermaid\ngraph TD\n  A --> B\n```\n[^1]: A *note*",
+	}
+
+	for _, test := range tests {
+		nodes, err := parser.Parse(tokenizer.Tokenize(test))
+		require.NoError(t, err)
+		require.Equal(t, test, NewMarkdownRenderer().Render(nodes))
+	}
+}
@@ -15,6 +15,7 @@ import (
 type Handler interface {
 	BotToken(ctx context.Context) string
 	MessageHandle(ctx context.Context, bot *Bot, message Message, attachments []Attachment) error
+	CommandHandle(ctx context.Context, bot *Bot, message Message, command Command) error
 	CallbackQueryHandle(ctx context.Context, bot *Bot, callbackQuery CallbackQuery) error
 }
 
@@ -0,0 +1,37 @@
+package telegram
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Command is a bot command (/command@bot_name arguments) sent at the start of a text message.
+type Command struct {
+	Name string
+	Args string
+}
+
+// GetCommand returns the bot command at the start of the message, or nil if the message is not a command.
+func (m Message) GetCommand() *Command {
+	if m.Text == nil {
+		return nil
+	}
+	for _, e := range m.Entities {
+		if e.Type != BotCommand || e.Offset != 0 {
+			continue
+		}
+
+		text := utf16.Encode([]rune(*m.Text))
+		if e.Length > len(text) {
+			return nil
+		}
+		name := string(utf16.Decode(text[1:e.Length]))
+		// Commands in group chats are suffixed with the bot name.
+		name, _, _ = strings.Cut(name, "@")
+		return &Command{
+			Name: strings.ToLower(name),
+			Args: strings.TrimSpace(string(utf16.Decode(text[e.Length:]))),
+		}
+	}
+	return nil
+}
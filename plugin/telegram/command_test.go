@@ -0,0 +1,53 @@
+package telegram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCommand(t *testing.T) {
+	tests := []struct {
+		text     string
+		entities []MessageEntity
+		expected *Command
+	}{
+		{
+			text:     "hello world",
+			expected: nil,
+		},
+		{
+			text: "/recent",
+			entities: []MessageEntity{
+				{Type: BotCommand, Offset: 0, Length: 7},
+			},
+			expected: &Command{Name: "recent"},
+		},
+		{
+			text: "/search@memos_bot  hello world ",
+			entities: []MessageEntity{
+				{Type: BotCommand, Offset: 0, Length: 17},
+			},
+			expected: &Command{Name: "search", Args: "hello world"},
+		},
+		{
+			text: "/tag 😀/emoji",
+			entities: []MessageEntity{
+				{Type: BotCommand, Offset: 0, Length: 4},
+			},
+			expected: &Command{Name: "tag", Args: "😀/emoji"},
+		},
+		{
+			text: "see /recent",
+			entities: []MessageEntity{
+				{Type: BotCommand, Offset: 4, Length: 7},
+			},
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		text := test.text
+		message := Message{Text: &text, Entities: test.entities}
+		require.Equal(t, test.expected, message.GetCommand(), test.text)
+	}
+}
@@ -9,6 +9,13 @@ func (b *Bot) handleSingleMessages(ctx context.Context, messages []Message) erro
 	var attachments []Attachment
 
 	for _, message := range messages {
+		if command := message.GetCommand(); command != nil {
+			if err := b.handler.CommandHandle(ctx, b, message, *command); err != nil {
+				return err
+			}
+			continue
+		}
+
 		attachment, err := b.downloadAttachment(ctx, &message)
 		if err != nil {
 			return err
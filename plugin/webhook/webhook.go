@@ -2,6 +2,9 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -15,6 +18,9 @@ var (
 	timeout = 30 * time.Second
 )
 
+// maxResponseBodySize limits the response body read from the webhook endpoint, the rest is discarded.
+const maxResponseBodySize = 64 << 10
+
 type Memo struct {
 	ID        int32 `json:"id"`
 	CreatorID int32 `json:"creatorId"`
@@ -68,43 +74,78 @@ type WebhookResponse struct {
 	Message string `json:"message"`
 }
 
+// SignatureHeader is the request header carrying the signature of the request body.
+const SignatureHeader = "X-Memos-Signature"
+
+// DeliveryResult is the outcome of a webhook request.
+type DeliveryResult struct {
+	StatusCode int
+	Body       []byte
+	Latency    time.Duration
+}
+
+// Sign returns the signature of the body in the form of `sha256=<hex encoded HMAC-SHA256>`.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
 // Post posts the message to webhook endpoint.
 func Post(payload WebhookPayload) error {
 	body, err := json.Marshal(&payload)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook request to %s", payload.URL)
 	}
-	req, err := http.NewRequest("POST",
-		payload.URL, bytes.NewBuffer(body))
+	result, err := Deliver(payload.URL, body, "")
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook request to %s", payload.URL)
+		return err
+	}
+	return result.Check()
+}
+
+// Deliver posts the body to the webhook endpoint, and signs it if the secret is not empty.
+func Deliver(url string, body []byte, secret string) (*DeliveryResult, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct webhook request to %s", url)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
 	client := &http.Client{
 		Timeout: timeout,
 	}
+	startTime := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to post webhook to %s", payload.URL)
+		return nil, errors.Wrapf(err, "failed to post webhook to %s", url)
 	}
+	defer resp.Body.Close()
 
-	b, err := io.ReadAll(resp.Body)
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
 	if err != nil {
-		return errors.Wrapf(err, "failed to read webhook response from %s", payload.URL)
+		return nil, errors.Wrapf(err, "failed to read webhook response from %s", url)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", payload.URL, resp.StatusCode, b)
-	}
+	return &DeliveryResult{
+		StatusCode: resp.StatusCode,
+		Body:       b,
+		Latency:    time.Since(startTime),
+	}, nil
+}
 
-	response := &WebhookResponse{}
-	if err := json.Unmarshal(b, response); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", payload.URL)
+// Check returns an error if the webhook server did not accept the request.
+// A JSON response with a non-zero code is treated as a rejection.
+func (r *DeliveryResult) Check() error {
+	if r.StatusCode < http.StatusOK || r.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("unexpected status code: %d, response body: %s", r.StatusCode, r.Body)
 	}
 
-	if response.Code != 0 {
+	response := &WebhookResponse{}
+	if err := json.Unmarshal(r.Body, response); err == nil && response.Code != 0 {
 		return errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
 	}
 
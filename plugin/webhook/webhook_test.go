@@ -1 +1,52 @@
 package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	require.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
+
+func TestDeliver(t *testing.T) {
+	body := []byte(`{"activityType":"memos.memo.created"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, body, received)
+		if r.Header.Get(SignatureHeader) != Sign("secret", received) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	result, err := Deliver(server.URL, body, "secret")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.StatusCode)
+	require.NoError(t, result.Check())
+
+	result, err = Deliver(server.URL, body, "wrong")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, result.StatusCode)
+	require.Error(t, result.Check())
+}
+
+func TestDeliverLargeResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("a", 4*maxResponseBodySize)))
+	}))
+	defer server.Close()
+
+	result, err := Deliver(server.URL, []byte(`{}`), "")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.StatusCode)
+	require.Len(t, result.Body, maxResponseBodySize)
+}
@@ -0,0 +1,1074 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: api/v2/memo_relation_service.proto
+
+package apiv2
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MemoRelation_Type int32
+
+const (
+	MemoRelation_TYPE_UNSPECIFIED MemoRelation_Type = 0
+	MemoRelation_REFERENCE        MemoRelation_Type = 1
+	MemoRelation_COMMENT          MemoRelation_Type = 2
+)
+
+// Enum value maps for MemoRelation_Type.
+var (
+	MemoRelation_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "REFERENCE",
+		2: "COMMENT",
+	}
+	MemoRelation_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"REFERENCE":        1,
+		"COMMENT":          2,
+	}
+)
+
+func (x MemoRelation_Type) Enum() *MemoRelation_Type {
+	p := new(MemoRelation_Type)
+	*p = x
+	return p
+}
+
+func (x MemoRelation_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoRelation_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_memo_relation_service_proto_enumTypes[0].Descriptor()
+}
+
+func (MemoRelation_Type) Type() protoreflect.EnumType {
+	return &file_api_v2_memo_relation_service_proto_enumTypes[0]
+}
+
+func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoRelation_Type.Descriptor instead.
+func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type GetMemoGraphRequest_Direction int32
+
+const (
+	// Both directions.
+	GetMemoGraphRequest_DIRECTION_UNSPECIFIED GetMemoGraphRequest_Direction = 0
+	// The relations from the memos, e.g. the memos they reference.
+	GetMemoGraphRequest_OUTGOING GetMemoGraphRequest_Direction = 1
+	// The relations to the memos, e.g. their backlinks and comments.
+	GetMemoGraphRequest_INCOMING GetMemoGraphRequest_Direction = 2
+)
+
+// Enum value maps for GetMemoGraphRequest_Direction.
+var (
+	GetMemoGraphRequest_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "OUTGOING",
+		2: "INCOMING",
+	}
+	GetMemoGraphRequest_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"OUTGOING":              1,
+		"INCOMING":              2,
+	}
+)
+
+func (x GetMemoGraphRequest_Direction) Enum() *GetMemoGraphRequest_Direction {
+	p := new(GetMemoGraphRequest_Direction)
+	*p = x
+	return p
+}
+
+func (x GetMemoGraphRequest_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetMemoGraphRequest_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_memo_relation_service_proto_enumTypes[1].Descriptor()
+}
+
+func (GetMemoGraphRequest_Direction) Type() protoreflect.EnumType {
+	return &file_api_v2_memo_relation_service_proto_enumTypes[1]
+}
+
+func (x GetMemoGraphRequest_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetMemoGraphRequest_Direction.Descriptor instead.
+func (GetMemoGraphRequest_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type RandomWalkStep_Reason int32
+
+const (
+	RandomWalkStep_REASON_UNSPECIFIED RandomWalkStep_Reason = 0
+	// The memo the walk started from.
+	RandomWalkStep_START RandomWalkStep_Reason = 1
+	// The memo references or is referenced by the previous memo.
+	RandomWalkStep_REFERENCE RandomWalkStep_Reason = 2
+	// The memo shares a tag with the previous memo.
+	RandomWalkStep_SHARED_TAG RandomWalkStep_Reason = 3
+	// The memo was created around the time of the previous memo.
+	RandomWalkStep_TIME_PROXIMITY RandomWalkStep_Reason = 4
+	// The previous memo had no unvisited neighbors, so the walk jumped to a random memo.
+	RandomWalkStep_RANDOM_JUMP RandomWalkStep_Reason = 5
+)
+
+// Enum value maps for RandomWalkStep_Reason.
+var (
+	RandomWalkStep_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "START",
+		2: "REFERENCE",
+		3: "SHARED_TAG",
+		4: "TIME_PROXIMITY",
+		5: "RANDOM_JUMP",
+	}
+	RandomWalkStep_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED": 0,
+		"START":              1,
+		"REFERENCE":          2,
+		"SHARED_TAG":         3,
+		"TIME_PROXIMITY":     4,
+		"RANDOM_JUMP":        5,
+	}
+)
+
+func (x RandomWalkStep_Reason) Enum() *RandomWalkStep_Reason {
+	p := new(RandomWalkStep_Reason)
+	*p = x
+	return p
+}
+
+func (x RandomWalkStep_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RandomWalkStep_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_memo_relation_service_proto_enumTypes[2].Descriptor()
+}
+
+func (RandomWalkStep_Reason) Type() protoreflect.EnumType {
+	return &file_api_v2_memo_relation_service_proto_enumTypes[2]
+}
+
+func (x RandomWalkStep_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RandomWalkStep_Reason.Descriptor instead.
+func (RandomWalkStep_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type MemoRelation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemoId        int32             `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	RelatedMemoId int32             `protobuf:"varint,2,opt,name=related_memo_id,json=relatedMemoId,proto3" json:"related_memo_id,omitempty"`
+	Type          MemoRelation_Type `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v2.MemoRelation_Type" json:"type,omitempty"`
+	// The name of the custom relation type of the user, when the type is unspecified.
+	CustomType string `protobuf:"bytes,4,opt,name=custom_type,json=customType,proto3" json:"custom_type,omitempty"`
+}
+
+func (x *MemoRelation) Reset() {
+	*x = MemoRelation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRelation) ProtoMessage() {}
+
+func (x *MemoRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
+func (*MemoRelation) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MemoRelation) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *MemoRelation) GetRelatedMemoId() int32 {
+	if x != nil {
+		return x.RelatedMemoId
+	}
+	return 0
+}
+
+func (x *MemoRelation) GetType() MemoRelation_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemoRelation_TYPE_UNSPECIFIED
+}
+
+func (x *MemoRelation) GetCustomType() string {
+	if x != nil {
+		return x.CustomType
+	}
+	return ""
+}
+
+type MemoGraphNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The number of hops from the requested memo.
+	Depth int32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// The ids of the visible memos referencing the memo.
+	BacklinkIds []int32 `protobuf:"varint,3,rep,packed,name=backlink_ids,json=backlinkIds,proto3" json:"backlink_ids,omitempty"`
+}
+
+func (x *MemoGraphNode) Reset() {
+	*x = MemoGraphNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoGraphNode) ProtoMessage() {}
+
+func (x *MemoGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoGraphNode.ProtoReflect.Descriptor instead.
+func (*MemoGraphNode) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MemoGraphNode) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *MemoGraphNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *MemoGraphNode) GetBacklinkIds() []int32 {
+	if x != nil {
+		return x.BacklinkIds
+	}
+	return nil
+}
+
+type GetMemoGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The number of hops to traverse, 1 by default and at most 5.
+	Depth     int32                         `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Direction GetMemoGraphRequest_Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=memos.api.v2.GetMemoGraphRequest_Direction" json:"direction,omitempty"`
+	// The relation types to traverse, all types if both types and custom_types are empty.
+	Types []MemoRelation_Type `protobuf:"varint,4,rep,packed,name=types,proto3,enum=memos.api.v2.MemoRelation_Type" json:"types,omitempty"`
+	// The names of the custom relation types to traverse.
+	CustomTypes []string `protobuf:"bytes,5,rep,name=custom_types,json=customTypes,proto3" json:"custom_types,omitempty"`
+}
+
+func (x *GetMemoGraphRequest) Reset() {
+	*x = GetMemoGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemoGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoGraphRequest) ProtoMessage() {}
+
+func (x *GetMemoGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoGraphRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMemoGraphRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetMemoGraphRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetMemoGraphRequest) GetDirection() GetMemoGraphRequest_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return GetMemoGraphRequest_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetMemoGraphRequest) GetTypes() []MemoRelation_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetMemoGraphRequest) GetCustomTypes() []string {
+	if x != nil {
+		return x.CustomTypes
+	}
+	return nil
+}
+
+type GetMemoGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The nodes sorted by depth and id, starting with the requested memo.
+	Nodes []*MemoGraphNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*MemoRelation  `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *GetMemoGraphResponse) Reset() {
+	*x = GetMemoGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemoGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoGraphResponse) ProtoMessage() {}
+
+func (x *GetMemoGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoGraphResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMemoGraphResponse) GetNodes() []*MemoGraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetMemoGraphResponse) GetEdges() []*MemoRelation {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type ListMemoBacklinksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListMemoBacklinksRequest) Reset() {
+	*x = ListMemoBacklinksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMemoBacklinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoBacklinksRequest) ProtoMessage() {}
+
+func (x *ListMemoBacklinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoBacklinksRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoBacklinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMemoBacklinksRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListMemoBacklinksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+}
+
+func (x *ListMemoBacklinksResponse) Reset() {
+	*x = ListMemoBacklinksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMemoBacklinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoBacklinksResponse) ProtoMessage() {}
+
+func (x *ListMemoBacklinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoBacklinksResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoBacklinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMemoBacklinksResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+type RandomWalkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the memo to start from, or 0 to start from a random memo.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The number of memos in the path, 5 by default.
+	Steps int32 `protobuf:"varint,2,opt,name=steps,proto3" json:"steps,omitempty"`
+	// The session of the walk, which avoids the memos visited recently in it.
+	// A new session is started if empty.
+	Session string `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *RandomWalkRequest) Reset() {
+	*x = RandomWalkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomWalkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomWalkRequest) ProtoMessage() {}
+
+func (x *RandomWalkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomWalkRequest.ProtoReflect.Descriptor instead.
+func (*RandomWalkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RandomWalkRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RandomWalkRequest) GetSteps() int32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *RandomWalkRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+type RandomWalkStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Memo   *Memo                 `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	Reason RandomWalkStep_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=memos.api.v2.RandomWalkStep_Reason" json:"reason,omitempty"`
+	// The shared tag, for the SHARED_TAG reason.
+	Tag string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The probability the memo had to be chosen.
+	Probability float64 `protobuf:"fixed64,4,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (x *RandomWalkStep) Reset() {
+	*x = RandomWalkStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomWalkStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomWalkStep) ProtoMessage() {}
+
+func (x *RandomWalkStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomWalkStep.ProtoReflect.Descriptor instead.
+func (*RandomWalkStep) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RandomWalkStep) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *RandomWalkStep) GetReason() RandomWalkStep_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return RandomWalkStep_REASON_UNSPECIFIED
+}
+
+func (x *RandomWalkStep) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *RandomWalkStep) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type RandomWalkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path []*RandomWalkStep `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	// The session to pass in the next request to continue the walk.
+	Session string `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *RandomWalkResponse) Reset() {
+	*x = RandomWalkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_relation_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomWalkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomWalkResponse) ProtoMessage() {}
+
+func (x *RandomWalkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_relation_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomWalkResponse.ProtoReflect.Descriptor instead.
+func (*RandomWalkResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_relation_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RandomWalkResponse) GetPath() []*RandomWalkStep {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *RandomWalkResponse) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+var File_api_v2_memo_relation_service_proto protoreflect.FileDescriptor
+
+var file_api_v2_memo_relation_service_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x32, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x01, 0x0a, 0x0c,
+	0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d,
+	0x65, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x33, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65,
+	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x38, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x22, 0x70, 0x0a,
+	0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x26,
+	0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
+	0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x73, 0x22,
+	0xa4, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x49, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x22, 0x42, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x15, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x55,
+	0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x43, 0x4f,
+	0x4d, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x22, 0x7b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6d,
+	0x6f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65, 0x64,
+	0x67, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x42,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x45, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
+	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x52,
+	0x05, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x22, 0x53, 0x0a, 0x11, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x57, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x02, 0x0a, 0x0e,
+	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x12, 0x26,
+	0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
+	0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6b,
+	0x53, 0x74, 0x65, 0x70, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x6f, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43,
+	0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x41,
+	0x47, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x58,
+	0x49, 0x4d, 0x49, 0x54, 0x59, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x4e, 0x44, 0x4f,
+	0x4d, 0x5f, 0x4a, 0x55, 0x4d, 0x50, 0x10, 0x05, 0x22, 0x60, 0x0a, 0x12, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d,
+	0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x8f, 0x03, 0x0a, 0x13, 0x4d,
+	0x65, 0x6d, 0x6f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x8a, 0x01, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x6e, 0x6b,
+	0x73, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x62,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x72, 0x0a, 0x0a, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6b, 0x12, 0x1f, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x61, 0x6c,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x73,
+	0x3a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2d, 0x77, 0x61, 0x6c, 0x6b, 0x42, 0xb0, 0x01, 0x0a,
+	0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x32, 0x42, 0x18, 0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x73, 0x65, 0x6d, 0x65, 0x6d,
+	0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x3b, 0x61, 0x70, 0x69, 0x76, 0x32, 0xa2,
+	0x02, 0x03, 0x4d, 0x41, 0x58, 0xaa, 0x02, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x70,
+	0x69, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x69,
+	0x5c, 0x56, 0x32, 0xe2, 0x02, 0x18, 0x4d, 0x65, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x69, 0x5c,
+	0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0e, 0x4d, 0x65, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x32, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v2_memo_relation_service_proto_rawDescOnce sync.Once
+	file_api_v2_memo_relation_service_proto_rawDescData = file_api_v2_memo_relation_service_proto_rawDesc
+)
+
+func file_api_v2_memo_relation_service_proto_rawDescGZIP() []byte {
+	file_api_v2_memo_relation_service_proto_rawDescOnce.Do(func() {
+		file_api_v2_memo_relation_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v2_memo_relation_service_proto_rawDescData)
+	})
+	return file_api_v2_memo_relation_service_proto_rawDescData
+}
+
+var file_api_v2_memo_relation_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v2_memo_relation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v2_memo_relation_service_proto_goTypes = []interface{}{
+	(MemoRelation_Type)(0),             // 0: memos.api.v2.MemoRelation.Type
+	(GetMemoGraphRequest_Direction)(0), // 1: memos.api.v2.GetMemoGraphRequest.Direction
+	(RandomWalkStep_Reason)(0),         // 2: memos.api.v2.RandomWalkStep.Reason
+	(*MemoRelation)(nil),               // 3: memos.api.v2.MemoRelation
+	(*MemoGraphNode)(nil),              // 4: memos.api.v2.MemoGraphNode
+	(*GetMemoGraphRequest)(nil),        // 5: memos.api.v2.GetMemoGraphRequest
+	(*GetMemoGraphResponse)(nil),       // 6: memos.api.v2.GetMemoGraphResponse
+	(*ListMemoBacklinksRequest)(nil),   // 7: memos.api.v2.ListMemoBacklinksRequest
+	(*ListMemoBacklinksResponse)(nil),  // 8: memos.api.v2.ListMemoBacklinksResponse
+	(*RandomWalkRequest)(nil),          // 9: memos.api.v2.RandomWalkRequest
+	(*RandomWalkStep)(nil),             // 10: memos.api.v2.RandomWalkStep
+	(*RandomWalkResponse)(nil),         // 11: memos.api.v2.RandomWalkResponse
+	(*Memo)(nil),                       // 12: memos.api.v2.Memo
+}
+var file_api_v2_memo_relation_service_proto_depIdxs = []int32{
+	0,  // 0: memos.api.v2.MemoRelation.type:type_name -> memos.api.v2.MemoRelation.Type
+	12, // 1: memos.api.v2.MemoGraphNode.memo:type_name -> memos.api.v2.Memo
+	1,  // 2: memos.api.v2.GetMemoGraphRequest.direction:type_name -> memos.api.v2.GetMemoGraphRequest.Direction
+	0,  // 3: memos.api.v2.GetMemoGraphRequest.types:type_name -> memos.api.v2.MemoRelation.Type
+	4,  // 4: memos.api.v2.GetMemoGraphResponse.nodes:type_name -> memos.api.v2.MemoGraphNode
+	3,  // 5: memos.api.v2.GetMemoGraphResponse.edges:type_name -> memos.api.v2.MemoRelation
+	12, // 6: memos.api.v2.ListMemoBacklinksResponse.memos:type_name -> memos.api.v2.Memo
+	12, // 7: memos.api.v2.RandomWalkStep.memo:type_name -> memos.api.v2.Memo
+	2,  // 8: memos.api.v2.RandomWalkStep.reason:type_name -> memos.api.v2.RandomWalkStep.Reason
+	10, // 9: memos.api.v2.RandomWalkResponse.path:type_name -> memos.api.v2.RandomWalkStep
+	5,  // 10: memos.api.v2.MemoRelationService.GetMemoGraph:input_type -> memos.api.v2.GetMemoGraphRequest
+	7,  // 11: memos.api.v2.MemoRelationService.ListMemoBacklinks:input_type -> memos.api.v2.ListMemoBacklinksRequest
+	9,  // 12: memos.api.v2.MemoRelationService.RandomWalk:input_type -> memos.api.v2.RandomWalkRequest
+	6,  // 13: memos.api.v2.MemoRelationService.GetMemoGraph:output_type -> memos.api.v2.GetMemoGraphResponse
+	8,  // 14: memos.api.v2.MemoRelationService.ListMemoBacklinks:output_type -> memos.api.v2.ListMemoBacklinksResponse
+	11, // 15: memos.api.v2.MemoRelationService.RandomWalk:output_type -> memos.api.v2.RandomWalkResponse
+	13, // [13:16] is the sub-list for method output_type
+	10, // [10:13] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_api_v2_memo_relation_service_proto_init() }
+func file_api_v2_memo_relation_service_proto_init() {
+	if File_api_v2_memo_relation_service_proto != nil {
+		return
+	}
+	file_api_v2_memo_service_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_api_v2_memo_relation_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoRelation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v2_memo_relation_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoGraphNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v2_memo_relation_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemoGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v2_memo_relation_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemoGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v2_memo_relation_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemoBacklinksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v2_memo_relation_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemoBacklinksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v2_memo_relation_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomWalkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v2_memo_relation_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomWalkStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v2_memo_relation_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomWalkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_v2_memo_relation_service_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v2_memo_relation_service_proto_goTypes,
+		DependencyIndexes: file_api_v2_memo_relation_service_proto_depIdxs,
+		EnumInfos:         file_api_v2_memo_relation_service_proto_enumTypes,
+		MessageInfos:      file_api_v2_memo_relation_service_proto_msgTypes,
+	}.Build()
+	File_api_v2_memo_relation_service_proto = out.File
+	file_api_v2_memo_relation_service_proto_rawDesc = nil
+	file_api_v2_memo_relation_service_proto_goTypes = nil
+	file_api_v2_memo_relation_service_proto_depIdxs = nil
+}
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: api/v2/memo_relation_service.proto
+
+package apiv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MemoRelationService_GetMemoGraph_FullMethodName      = "/memos.api.v2.MemoRelationService/GetMemoGraph"
+	MemoRelationService_ListMemoBacklinks_FullMethodName = "/memos.api.v2.MemoRelationService/ListMemoBacklinks"
+	MemoRelationService_RandomWalk_FullMethodName        = "/memos.api.v2.MemoRelationService/RandomWalk"
+)
+
+// MemoRelationServiceClient is the client API for MemoRelationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MemoRelationServiceClient interface {
+	// GetMemoGraph returns the memos related to a memo up to a depth, with the relations between them.
+	// Only the memos visible to the current user are traversed.
+	GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*GetMemoGraphResponse, error)
+	// ListMemoBacklinks lists the visible memos referencing a memo.
+	ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error)
+	// RandomWalk walks the memos of the current user, picking each next memo by weighted choice
+	// over its references, shared tags and creation time.
+	RandomWalk(ctx context.Context, in *RandomWalkRequest, opts ...grpc.CallOption) (*RandomWalkResponse, error)
+}
+
+type memoRelationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemoRelationServiceClient(cc grpc.ClientConnInterface) MemoRelationServiceClient {
+	return &memoRelationServiceClient{cc}
+}
+
+func (c *memoRelationServiceClient) GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*GetMemoGraphResponse, error) {
+	out := new(GetMemoGraphResponse)
+	err := c.cc.Invoke(ctx, MemoRelationService_GetMemoGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRelationServiceClient) ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error) {
+	out := new(ListMemoBacklinksResponse)
+	err := c.cc.Invoke(ctx, MemoRelationService_ListMemoBacklinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRelationServiceClient) RandomWalk(ctx context.Context, in *RandomWalkRequest, opts ...grpc.CallOption) (*RandomWalkResponse, error) {
+	out := new(RandomWalkResponse)
+	err := c.cc.Invoke(ctx, MemoRelationService_RandomWalk_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoRelationServiceServer is the server API for MemoRelationService service.
+// All implementations must embed UnimplementedMemoRelationServiceServer
+// for forward compatibility
+type MemoRelationServiceServer interface {
+	// GetMemoGraph returns the memos related to a memo up to a depth, with the relations between them.
+	// Only the memos visible to the current user are traversed.
+	GetMemoGraph(context.Context, *GetMemoGraphRequest) (*GetMemoGraphResponse, error)
+	// ListMemoBacklinks lists the visible memos referencing a memo.
+	ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error)
+	// RandomWalk walks the memos of the current user, picking each next memo by weighted choice
+	// over its references, shared tags and creation time.
+	RandomWalk(context.Context, *RandomWalkRequest) (*RandomWalkResponse, error)
+	mustEmbedUnimplementedMemoRelationServiceServer()
+}
+
+// UnimplementedMemoRelationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMemoRelationServiceServer struct {
+}
+
+func (UnimplementedMemoRelationServiceServer) GetMemoGraph(context.Context, *GetMemoGraphRequest) (*GetMemoGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoGraph not implemented")
+}
+func (UnimplementedMemoRelationServiceServer) ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoBacklinks not implemented")
+}
+func (UnimplementedMemoRelationServiceServer) RandomWalk(context.Context, *RandomWalkRequest) (*RandomWalkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomWalk not implemented")
+}
+func (UnimplementedMemoRelationServiceServer) mustEmbedUnimplementedMemoRelationServiceServer() {}
+
+// UnsafeMemoRelationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemoRelationServiceServer will
+// result in compilation errors.
+type UnsafeMemoRelationServiceServer interface {
+	mustEmbedUnimplementedMemoRelationServiceServer()
+}
+
+func RegisterMemoRelationServiceServer(s grpc.ServiceRegistrar, srv MemoRelationServiceServer) {
+	s.RegisterService(&MemoRelationService_ServiceDesc, srv)
+}
+
+func _MemoRelationService_GetMemoGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRelationServiceServer).GetMemoGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRelationService_GetMemoGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRelationServiceServer).GetMemoGraph(ctx, req.(*GetMemoGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRelationService_ListMemoBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoBacklinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRelationServiceServer).ListMemoBacklinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRelationService_ListMemoBacklinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRelationServiceServer).ListMemoBacklinks(ctx, req.(*ListMemoBacklinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRelationService_RandomWalk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomWalkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRelationServiceServer).RandomWalk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRelationService_RandomWalk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRelationServiceServer).RandomWalk(ctx, req.(*RandomWalkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoRelationService_ServiceDesc is the grpc.ServiceDesc for MemoRelationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoRelationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v2.MemoRelationService",
+	HandlerType: (*MemoRelationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMemoGraph",
+			Handler:    _MemoRelationService_GetMemoGraph_Handler,
+		},
+		{
+			MethodName: "ListMemoBacklinks",
+			Handler:    _MemoRelationService_ListMemoBacklinks_Handler,
+		},
+		{
+			MethodName: "RandomWalk",
+			Handler:    _MemoRelationService_RandomWalk_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v2/memo_relation_service.proto",
+}
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MemoService_CreateMemo_FullMethodName        = "/memos.api.v2.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName         = "/memos.api.v2.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName           = "/memos.api.v2.MemoService/GetMemo"
-	MemoService_CreateMemoComment_FullMethodName = "/memos.api.v2.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName  = "/memos.api.v2.MemoService/ListMemoComments"
+	MemoService_CreateMemo_FullMethodName          = "/memos.api.v2.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName           = "/memos.api.v2.MemoService/ListMemos"
+	MemoService_GetMemo_FullMethodName             = "/memos.api.v2.MemoService/GetMemo"
+	MemoService_CreateMemoComment_FullMethodName   = "/memos.api.v2.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName    = "/memos.api.v2.MemoService/ListMemoComments"
+	MemoService_ListMemoRevisions_FullMethodName   = "/memos.api.v2.MemoService/ListMemoRevisions"
+	MemoService_GetMemoRevision_FullMethodName     = "/memos.api.v2.MemoService/GetMemoRevision"
+	MemoService_DiffMemoRevision_FullMethodName    = "/memos.api.v2.MemoService/DiffMemoRevision"
+	MemoService_RestoreMemoRevision_FullMethodName = "/memos.api.v2.MemoService/RestoreMemoRevision"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -35,6 +39,10 @@ type MemoServiceClient interface {
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*GetMemoResponse, error)
 	CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*CreateMemoCommentResponse, error)
 	ListMemoComments(ctx context.Context, in *ListMemoCommentsRequest, opts ...grpc.CallOption) (*ListMemoCommentsResponse, error)
+	ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error)
+	GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*GetMemoRevisionResponse, error)
+	DiffMemoRevision(ctx context.Context, in *DiffMemoRevisionRequest, opts ...grpc.CallOption) (*DiffMemoRevisionResponse, error)
+	RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*RestoreMemoRevisionResponse, error)
 }
 
 type memoServiceClient struct {
@@ -90,6 +98,42 @@ func (c *memoServiceClient) ListMemoComments(ctx context.Context, in *ListMemoCo
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error) {
+	out := new(ListMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*GetMemoRevisionResponse, error) {
+	out := new(GetMemoRevisionResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DiffMemoRevision(ctx context.Context, in *DiffMemoRevisionRequest, opts ...grpc.CallOption) (*DiffMemoRevisionResponse, error) {
+	out := new(DiffMemoRevisionResponse)
+	err := c.cc.Invoke(ctx, MemoService_DiffMemoRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*RestoreMemoRevisionResponse, error) {
+	out := new(RestoreMemoRevisionResponse)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemoRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility
@@ -99,6 +143,10 @@ type MemoServiceServer interface {
 	GetMemo(context.Context, *GetMemoRequest) (*GetMemoResponse, error)
 	CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*CreateMemoCommentResponse, error)
 	ListMemoComments(context.Context, *ListMemoCommentsRequest) (*ListMemoCommentsResponse, error)
+	ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error)
+	GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*GetMemoRevisionResponse, error)
+	DiffMemoRevision(context.Context, *DiffMemoRevisionRequest) (*DiffMemoRevisionResponse, error)
+	RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*RestoreMemoRevisionResponse, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -121,6 +169,18 @@ func (UnimplementedMemoServiceServer) CreateMemoComment(context.Context, *Create
 func (UnimplementedMemoServiceServer) ListMemoComments(context.Context, *ListMemoCommentsRequest) (*ListMemoCommentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemoComments not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*GetMemoRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) DiffMemoRevision(context.Context, *DiffMemoRevisionRequest) (*DiffMemoRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*RestoreMemoRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMemoRevision not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 
 // UnsafeMemoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +284,78 @@ func _MemoService_ListMemoComments_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, req.(*ListMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, req.(*GetMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DiffMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DiffMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DiffMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DiffMemoRevision(ctx, req.(*DiffMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, req.(*RestoreMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +383,22 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemoComments",
 			Handler:    _MemoService_ListMemoComments_Handler,
 		},
+		{
+			MethodName: "ListMemoRevisions",
+			Handler:    _MemoService_ListMemoRevisions_Handler,
+		},
+		{
+			MethodName: "GetMemoRevision",
+			Handler:    _MemoService_GetMemoRevision_Handler,
+		},
+		{
+			MethodName: "DiffMemoRevision",
+			Handler:    _MemoService_DiffMemoRevision_Handler,
+		},
+		{
+			MethodName: "RestoreMemoRevision",
+			Handler:    _MemoService_RestoreMemoRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v2/memo_service.proto",
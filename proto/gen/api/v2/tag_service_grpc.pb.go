@@ -24,6 +24,8 @@ const (
 	TagService_DeleteTag_FullMethodName         = "/memos.api.v2.TagService/DeleteTag"
 	TagService_GetTagSuggestions_FullMethodName = "/memos.api.v2.TagService/GetTagSuggestions"
 	TagService_RenameTag_FullMethodName         = "/memos.api.v2.TagService/RenameTag"
+	TagService_GetTagStats_FullMethodName       = "/memos.api.v2.TagService/GetTagStats"
+	TagService_MergeTags_FullMethodName         = "/memos.api.v2.TagService/MergeTags"
 )
 
 // TagServiceClient is the client API for TagService service.
@@ -35,6 +37,8 @@ type TagServiceClient interface {
 	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
 	GetTagSuggestions(ctx context.Context, in *GetTagSuggestionsRequest, opts ...grpc.CallOption) (*GetTagSuggestionsResponse, error)
 	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	GetTagStats(ctx context.Context, in *GetTagStatsRequest, opts ...grpc.CallOption) (*GetTagStatsResponse, error)
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
 }
 
 type tagServiceClient struct {
@@ -90,6 +94,24 @@ func (c *tagServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest,
 	return out, nil
 }
 
+func (c *tagServiceClient) GetTagStats(ctx context.Context, in *GetTagStatsRequest, opts ...grpc.CallOption) (*GetTagStatsResponse, error) {
+	out := new(GetTagStatsResponse)
+	err := c.cc.Invoke(ctx, TagService_GetTagStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_MergeTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TagServiceServer is the server API for TagService service.
 // All implementations must embed UnimplementedTagServiceServer
 // for forward compatibility
@@ -99,6 +121,8 @@ type TagServiceServer interface {
 	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
 	GetTagSuggestions(context.Context, *GetTagSuggestionsRequest) (*GetTagSuggestionsResponse, error)
 	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	GetTagStats(context.Context, *GetTagStatsRequest) (*GetTagStatsResponse, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
 	mustEmbedUnimplementedTagServiceServer()
 }
 
@@ -121,6 +145,12 @@ func (UnimplementedTagServiceServer) GetTagSuggestions(context.Context, *GetTagS
 func (UnimplementedTagServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
 }
+func (UnimplementedTagServiceServer) GetTagStats(context.Context, *GetTagStatsRequest) (*GetTagStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagStats not implemented")
+}
+func (UnimplementedTagServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
 func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
 
 // UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +254,42 @@ func _TagService_RenameTag_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_GetTagStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).GetTagStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_GetTagStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).GetTagStats(ctx, req.(*GetTagStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +317,14 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameTag",
 			Handler:    _TagService_RenameTag_Handler,
 		},
+		{
+			MethodName: "GetTagStats",
+			Handler:    _TagService_GetTagStats_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _TagService_MergeTags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v2/tag_service.proto",
@@ -19,15 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_GetUser_FullMethodName               = "/memos.api.v2.UserService/GetUser"
-	UserService_CreateUser_FullMethodName            = "/memos.api.v2.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName            = "/memos.api.v2.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName            = "/memos.api.v2.UserService/DeleteUser"
-	UserService_GetUserSetting_FullMethodName        = "/memos.api.v2.UserService/GetUserSetting"
-	UserService_UpdateUserSetting_FullMethodName     = "/memos.api.v2.UserService/UpdateUserSetting"
-	UserService_ListUserAccessTokens_FullMethodName  = "/memos.api.v2.UserService/ListUserAccessTokens"
-	UserService_CreateUserAccessToken_FullMethodName = "/memos.api.v2.UserService/CreateUserAccessToken"
-	UserService_DeleteUserAccessToken_FullMethodName = "/memos.api.v2.UserService/DeleteUserAccessToken"
+	UserService_GetUser_FullMethodName                = "/memos.api.v2.UserService/GetUser"
+	UserService_CreateUser_FullMethodName             = "/memos.api.v2.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName             = "/memos.api.v2.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName             = "/memos.api.v2.UserService/DeleteUser"
+	UserService_GetUserSetting_FullMethodName         = "/memos.api.v2.UserService/GetUserSetting"
+	UserService_UpdateUserSetting_FullMethodName      = "/memos.api.v2.UserService/UpdateUserSetting"
+	UserService_ListUserAccessTokens_FullMethodName   = "/memos.api.v2.UserService/ListUserAccessTokens"
+	UserService_CreateUserAccessToken_FullMethodName  = "/memos.api.v2.UserService/CreateUserAccessToken"
+	UserService_CreateTelegramLinkCode_FullMethodName = "/memos.api.v2.UserService/CreateTelegramLinkCode"
+	UserService_DeleteUserAccessToken_FullMethodName  = "/memos.api.v2.UserService/DeleteUserAccessToken"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -48,6 +49,9 @@ type UserServiceClient interface {
 	ListUserAccessTokens(ctx context.Context, in *ListUserAccessTokensRequest, opts ...grpc.CallOption) (*ListUserAccessTokensResponse, error)
 	// CreateUserAccessToken creates a new access token for a user.
 	CreateUserAccessToken(ctx context.Context, in *CreateUserAccessTokenRequest, opts ...grpc.CallOption) (*CreateUserAccessTokenResponse, error)
+	// CreateTelegramLinkCode creates a one-time code to link a telegram account to the user.
+	// The code is sent to the telegram bot with /link <code> from the telegram account, which proves its ownership.
+	CreateTelegramLinkCode(ctx context.Context, in *CreateTelegramLinkCodeRequest, opts ...grpc.CallOption) (*CreateTelegramLinkCodeResponse, error)
 	// DeleteUserAccessToken deletes an access token for a user.
 	DeleteUserAccessToken(ctx context.Context, in *DeleteUserAccessTokenRequest, opts ...grpc.CallOption) (*DeleteUserAccessTokenResponse, error)
 }
@@ -132,6 +136,15 @@ func (c *userServiceClient) CreateUserAccessToken(ctx context.Context, in *Creat
 	return out, nil
 }
 
+func (c *userServiceClient) CreateTelegramLinkCode(ctx context.Context, in *CreateTelegramLinkCodeRequest, opts ...grpc.CallOption) (*CreateTelegramLinkCodeResponse, error) {
+	out := new(CreateTelegramLinkCodeResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateTelegramLinkCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) DeleteUserAccessToken(ctx context.Context, in *DeleteUserAccessTokenRequest, opts ...grpc.CallOption) (*DeleteUserAccessTokenResponse, error) {
 	out := new(DeleteUserAccessTokenResponse)
 	err := c.cc.Invoke(ctx, UserService_DeleteUserAccessToken_FullMethodName, in, out, opts...)
@@ -159,6 +172,9 @@ type UserServiceServer interface {
 	ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error)
 	// CreateUserAccessToken creates a new access token for a user.
 	CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*CreateUserAccessTokenResponse, error)
+	// CreateTelegramLinkCode creates a one-time code to link a telegram account to the user.
+	// The code is sent to the telegram bot with /link <code> from the telegram account, which proves its ownership.
+	CreateTelegramLinkCode(context.Context, *CreateTelegramLinkCodeRequest) (*CreateTelegramLinkCodeResponse, error)
 	// DeleteUserAccessToken deletes an access token for a user.
 	DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*DeleteUserAccessTokenResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
@@ -192,6 +208,9 @@ func (UnimplementedUserServiceServer) ListUserAccessTokens(context.Context, *Lis
 func (UnimplementedUserServiceServer) CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*CreateUserAccessTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserAccessToken not implemented")
 }
+func (UnimplementedUserServiceServer) CreateTelegramLinkCode(context.Context, *CreateTelegramLinkCodeRequest) (*CreateTelegramLinkCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTelegramLinkCode not implemented")
+}
 func (UnimplementedUserServiceServer) DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*DeleteUserAccessTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserAccessToken not implemented")
 }
@@ -352,6 +371,24 @@ func _UserService_CreateUserAccessToken_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CreateTelegramLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTelegramLinkCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateTelegramLinkCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateTelegramLinkCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateTelegramLinkCode(ctx, req.(*CreateTelegramLinkCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_DeleteUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteUserAccessTokenRequest)
 	if err := dec(in); err != nil {
@@ -409,6 +446,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUserAccessToken",
 			Handler:    _UserService_CreateUserAccessToken_Handler,
 		},
+		{
+			MethodName: "CreateTelegramLinkCode",
+			Handler:    _UserService_CreateTelegramLinkCode_Handler,
+		},
 		{
 			MethodName: "DeleteUserAccessToken",
 			Handler:    _UserService_DeleteUserAccessToken_Handler,
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"unicode/utf16"
 
 	"github.com/pkg/errors"
@@ -22,23 +24,54 @@ func NewTelegramHandler(store *store.Store) *TelegramHandler {
 }
 
 func (t *TelegramHandler) BotToken(ctx context.Context) string {
-	return t.store.GetSystemSettingValueWithDefault(ctx, apiv1.SystemSettingTelegramBotTokenName.String(), "")
+	token := ""
+	_ = t.store.Guard(func() error {
+		token = t.store.GetSystemSettingValueWithDefault(ctx, apiv1.SystemSettingTelegramBotTokenName.String(), "")
+		return nil
+	})
+	return token
+}
+
+// MessageHandle saves the message as a memo.
+// The updates are handled with Store.Guard, so they wait for a database restore like the requests.
+func (t *TelegramHandler) MessageHandle(ctx context.Context, bot *telegram.Bot, message telegram.Message, attachments []telegram.Attachment) error {
+	return t.store.Guard(func() error {
+		return t.messageHandle(ctx, bot, message, attachments)
+	})
+}
+
+func (t *TelegramHandler) CommandHandle(ctx context.Context, bot *telegram.Bot, message telegram.Message, command telegram.Command) error {
+	return t.store.Guard(func() error {
+		return t.commandHandle(ctx, bot, message, command)
+	})
+}
+
+func (t *TelegramHandler) CallbackQueryHandle(ctx context.Context, bot *telegram.Bot, callbackQuery telegram.CallbackQuery) error {
+	return t.store.Guard(func() error {
+		return t.callbackQueryHandle(ctx, bot, callbackQuery)
+	})
 }
 
 const (
 	workingMessage = "Working on sending your memo..."
 	successMessage = "Success"
+	// notLinkedMessage is sent to the telegram accounts which are not linked to any user.
+	notLinkedMessage = "Your telegram account is not linked to any user. Please create a link code in the settings of memos, and send /link <code> here"
 )
 
-func (t *TelegramHandler) MessageHandle(ctx context.Context, bot *telegram.Bot, message telegram.Message, attachments []telegram.Attachment) error {
+func (t *TelegramHandler) messageHandle(ctx context.Context, bot *telegram.Bot, message telegram.Message, attachments []telegram.Attachment) error {
 	reply, err := bot.SendReplyMessage(ctx, message.Chat.ID, message.MessageID, workingMessage)
 	if err != nil {
 		return errors.Wrap(err, "Failed to SendReplyMessage")
 	}
 
-	var creatorID int32
+	creatorID, err := t.store.GetUserIDByTelegramUserID(ctx, strconv.FormatInt(message.From.ID, 10))
+	if err != nil {
+		_, err := bot.EditMessage(ctx, message.Chat.ID, reply.MessageID, fmt.Sprintf("Failed to find user: %s", err), nil)
+		return err
+	}
 	if creatorID == 0 {
-		_, err := bot.EditMessage(ctx, message.Chat.ID, reply.MessageID, fmt.Sprintf("Please set your telegram userid %d in UserSetting of memos", message.From.ID), nil)
+		_, err := bot.EditMessage(ctx, message.Chat.ID, reply.MessageID, notLinkedMessage, nil)
 		return err
 	}
 
@@ -94,7 +127,11 @@ func (t *TelegramHandler) MessageHandle(ctx context.Context, bot *telegram.Bot,
 	return err
 }
 
-func (t *TelegramHandler) CallbackQueryHandle(ctx context.Context, bot *telegram.Bot, callbackQuery telegram.CallbackQuery) error {
+func (t *TelegramHandler) callbackQueryHandle(ctx context.Context, bot *telegram.Bot, callbackQuery telegram.CallbackQuery) error {
+	if strings.HasPrefix(callbackQuery.Data, "/") {
+		return t.commandCallbackQueryHandle(ctx, bot, callbackQuery)
+	}
+
 	var memoID int32
 	var visibility store.Visibility
 	n, err := fmt.Sscanf(callbackQuery.Data, "%s %d", &visibility, &memoID)
@@ -102,6 +139,21 @@ func (t *TelegramHandler) CallbackQueryHandle(ctx context.Context, bot *telegram
 		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, fmt.Sprintf("Failed to parse callbackQuery.Data %s", callbackQuery.Data))
 	}
 
+	// Only the linked owner of the memo can change its visibility.
+	creatorID, err := t.store.GetUserIDByTelegramUserID(ctx, strconv.FormatInt(callbackQuery.From.ID, 10))
+	if err != nil {
+		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, fmt.Sprintf("Failed to find user: %s", err))
+	}
+	memo, err := t.store.GetMemo(ctx, &store.FindMemo{
+		ID: &memoID,
+	})
+	if err != nil {
+		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, fmt.Sprintf("Failed to call GetMemo %s", err))
+	}
+	if memo == nil || creatorID == 0 || memo.CreatorID != creatorID {
+		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, fmt.Sprintf("Memo %d not found", memoID))
+	}
+
 	update := store.UpdateMemo{
 		ID:         memoID,
 		Visibility: &visibility,
@@ -0,0 +1,300 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/telegram"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// commandPageSize is the number of memos listed in a page of the command results.
+	commandPageSize = 5
+	// maxRecentCount is the max number of memos listed by /recent, which keeps the message under the telegram length limit.
+	maxRecentCount = 10
+	// maxMemoPreviewLength is the max number of characters of a memo shown in the command results.
+	maxMemoPreviewLength = 150
+	// maxCallbackDataLength is the max length of the callback data of an inline keyboard button in bytes.
+	maxCallbackDataLength = 64
+)
+
+const helpMessage = `Send me a message to save it as a memo, or use the commands:
+/search <query> - Search your memos, e.g. "quoted phrase", prefix* or coffee NOT tea
+/recent [n] - List your latest memos
+/tag <name> - List your memos with the tag
+/append <id> <text> - Append the text to the memo
+/random - Review a random memo
+/link <code> - Link your telegram account with a code from the settings of memos`
+
+func (t *TelegramHandler) commandHandle(ctx context.Context, bot *telegram.Bot, message telegram.Message, command telegram.Command) error {
+	reply, err := bot.SendReplyMessage(ctx, message.Chat.ID, message.MessageID, workingMessage)
+	if err != nil {
+		return errors.Wrap(err, "Failed to SendReplyMessage")
+	}
+
+	// The accounts are linked by the commands sent from them, which proves their ownership.
+	if command.Name == "link" {
+		text, err := t.linkForCommand(ctx, message.From.ID, command)
+		if err != nil {
+			text = fmt.Sprintf("Failed to run /%s: %s", command.Name, err)
+		}
+		_, err = bot.EditMessage(ctx, message.Chat.ID, reply.MessageID, text, nil)
+		return err
+	}
+
+	creatorID, err := t.store.GetUserIDByTelegramUserID(ctx, strconv.FormatInt(message.From.ID, 10))
+	if err != nil {
+		_, err := bot.EditMessage(ctx, message.Chat.ID, reply.MessageID, fmt.Sprintf("Failed to find user: %s", err), nil)
+		return err
+	}
+	if creatorID == 0 {
+		_, err := bot.EditMessage(ctx, message.Chat.ID, reply.MessageID, notLinkedMessage, nil)
+		return err
+	}
+
+	text, keyboard, err := t.runCommand(ctx, creatorID, command, 0)
+	if err != nil {
+		text, keyboard = fmt.Sprintf("Failed to run /%s: %s", command.Name, err), nil
+	}
+	_, err = bot.EditMessage(ctx, message.Chat.ID, reply.MessageID, text, keyboard)
+	return err
+}
+
+// commandCallbackQueryHandle handles the inline keyboard buttons of the command results,
+// whose callback data is the command with the page to show.
+func (t *TelegramHandler) commandCallbackQueryHandle(ctx context.Context, bot *telegram.Bot, callbackQuery telegram.CallbackQuery) error {
+	command, page, ok := parseCommandCallbackData(callbackQuery.Data)
+	if !ok {
+		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, fmt.Sprintf("Failed to parse callbackQuery.Data %s", callbackQuery.Data))
+	}
+
+	creatorID, err := t.store.GetUserIDByTelegramUserID(ctx, strconv.FormatInt(callbackQuery.From.ID, 10))
+	if err != nil {
+		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, fmt.Sprintf("Failed to find user: %s", err))
+	}
+	if creatorID == 0 {
+		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, "Your telegram account is not linked to any user")
+	}
+
+	text, keyboard, err := t.runCommand(ctx, creatorID, command, page)
+	if err != nil {
+		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, fmt.Sprintf("Failed to run /%s: %s", command.Name, err))
+	}
+	if _, err := bot.EditMessage(ctx, callbackQuery.Message.Chat.ID, callbackQuery.Message.MessageID, text, keyboard); err != nil {
+		return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, fmt.Sprintf("Failed to EditMessage %s", err))
+	}
+	return bot.AnswerCallbackQuery(ctx, callbackQuery.ID, "")
+}
+
+// runCommand runs the command for the user and returns the result message with its inline keyboard.
+func (t *TelegramHandler) runCommand(ctx context.Context, creatorID int32, command telegram.Command, page int) (string, [][]telegram.InlineKeyboardButton, error) {
+	switch command.Name {
+	case "search":
+		if command.Args == "" {
+			return "Usage: /search <query>", nil, nil
+		}
+		// The query is searched with the same syntax and ranking as the search of the API.
+		find := &store.FindMemo{
+			SearchQuery: &command.Args,
+		}
+		text, keyboard, err := t.listMemosForCommand(ctx, creatorID, command, find, page, commandPageSize, fmt.Sprintf("Memos matching %q", command.Args))
+		if errors.Is(err, store.ErrMemoSearchNotSupported) {
+			return "Search is not supported by the database of memos", nil, nil
+		}
+		return text, keyboard, err
+	case "recent":
+		count := commandPageSize
+		if command.Args != "" {
+			n, err := strconv.Atoi(command.Args)
+			if err != nil || n <= 0 {
+				return "Usage: /recent [n]", nil, nil
+			}
+			count = min(n, maxRecentCount)
+		}
+		return t.listMemosForCommand(ctx, creatorID, command, &store.FindMemo{}, page, count, "Recent memos")
+	case "tag":
+		tag := strings.TrimPrefix(command.Args, "#")
+		if tag == "" || strings.ContainsAny(tag, " \t\n") {
+			return "Usage: /tag <name>", nil, nil
+		}
+		find := &store.FindMemo{
+			Tag: &tag,
+		}
+		return t.listMemosForCommand(ctx, creatorID, command, find, page, commandPageSize, fmt.Sprintf("Memos with tag #%s", tag))
+	case "append":
+		return t.appendMemoForCommand(ctx, creatorID, command)
+	case "random":
+		return t.randomMemoForCommand(ctx, creatorID, command)
+	case "start", "help":
+		return helpMessage, nil, nil
+	default:
+		return fmt.Sprintf("Unknown command /%s\n\n%s", command.Name, helpMessage), nil, nil
+	}
+}
+
+func (t *TelegramHandler) linkForCommand(ctx context.Context, telegramUserID int64, command telegram.Command) (string, error) {
+	if command.Args == "" {
+		return "Usage: /link <code>", nil
+	}
+	if _, err := t.store.LinkTelegramUser(ctx, command.Args, strconv.FormatInt(telegramUserID, 10)); err != nil {
+		if errors.Is(err, store.ErrInvalidTelegramLinkCode) {
+			return "The link code is invalid or expired. Please create a new one in the settings of memos", nil
+		}
+		return "", err
+	}
+	return "Your telegram account is linked. Send me a message to save it as a memo", nil
+}
+
+func (t *TelegramHandler) listMemosForCommand(ctx context.Context, creatorID int32, command telegram.Command, find *store.FindMemo, page, pageSize int, title string) (string, [][]telegram.InlineKeyboardButton, error) {
+	normalStatus := store.Normal
+	// Fetch one more memo to know if there is a next page.
+	limit, offset := pageSize+1, page*pageSize
+	find.CreatorID = &creatorID
+	find.RowStatus = &normalStatus
+	find.Limit = &limit
+	find.Offset = &offset
+	memos, err := t.store.ListMemos(ctx, find)
+	if err != nil {
+		return "", nil, err
+	}
+
+	hasNextPage := len(memos) > pageSize
+	if hasNextPage {
+		memos = memos[:pageSize]
+	}
+	if len(memos) == 0 {
+		if page == 0 {
+			return fmt.Sprintf("%s: no memos found", title), nil, nil
+		}
+		return fmt.Sprintf("%s: no more memos", title), generatePageKeyboard(command, page, false), nil
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%s (page %d):", title, page+1))
+	for _, memo := range memos {
+		builder.WriteString("\n\n")
+		builder.WriteString(formatMemoForCommand(memo))
+	}
+	return builder.String(), generatePageKeyboard(command, page, hasNextPage), nil
+}
+
+func (t *TelegramHandler) appendMemoForCommand(ctx context.Context, creatorID int32, command telegram.Command) (string, [][]telegram.InlineKeyboardButton, error) {
+	idString, text, _ := strings.Cut(command.Args, " ")
+	text = strings.TrimSpace(text)
+	memoID, err := strconv.ParseInt(idString, 10, 32)
+	if err != nil || text == "" {
+		return "Usage: /append <id> <text>", nil, nil
+	}
+
+	id := int32(memoID)
+	memo, err := t.store.GetMemo(ctx, &store.FindMemo{
+		ID: &id,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if memo == nil || memo.CreatorID != creatorID {
+		return fmt.Sprintf("Memo %d not found", id), nil, nil
+	}
+
+	content := strings.TrimRight(memo.Content, "\n") + "\n\n" + text
+	updatedTs := time.Now().Unix()
+	if err := t.store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		UpdatedTs: &updatedTs,
+		Content:   &content,
+	}); err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("Appended to %s Memo %d", memo.Visibility, memo.ID), generateKeyboardForMemoID(memo.ID), nil
+}
+
+func (t *TelegramHandler) randomMemoForCommand(ctx context.Context, creatorID int32, command telegram.Command) (string, [][]telegram.InlineKeyboardButton, error) {
+	normalStatus := store.Normal
+	memos, err := t.store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:      &creatorID,
+		RowStatus:      &normalStatus,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if len(memos) == 0 {
+		return "No memos to review", nil, nil
+	}
+
+	memo, err := t.store.GetMemo(ctx, &store.FindMemo{
+		ID: &memos[rand.Intn(len(memos))].ID,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if memo == nil {
+		return "", nil, errors.New("memo not found")
+	}
+	keyboard := [][]telegram.InlineKeyboardButton{{
+		{
+			Text:         "Another",
+			CallbackData: generateCommandCallbackData(command, 0),
+		},
+	}}
+	return fmt.Sprintf("Review this memo:\n\n%s", formatMemoForCommand(memo)), keyboard, nil
+}
+
+// formatMemoForCommand formats the memo as a preview in the command results.
+func formatMemoForCommand(memo *store.Memo) string {
+	content := []rune(strings.TrimSpace(memo.Content))
+	if len(content) > maxMemoPreviewLength {
+		content = append(content[:maxMemoPreviewLength], []rune("...")...)
+	}
+	createdTime := time.Unix(memo.CreatedTs, 0).Format("2006-01-02 15:04")
+	return fmt.Sprintf("Memo %d · %s · %s\n%s", memo.ID, memo.Visibility, createdTime, string(content))
+}
+
+func generatePageKeyboard(command telegram.Command, page int, hasNextPage bool) [][]telegram.InlineKeyboardButton {
+	buttons := []telegram.InlineKeyboardButton{}
+	if page > 0 {
+		buttons = append(buttons, telegram.InlineKeyboardButton{
+			Text:         "« Prev",
+			CallbackData: generateCommandCallbackData(command, page-1),
+		})
+	}
+	if hasNextPage {
+		buttons = append(buttons, telegram.InlineKeyboardButton{
+			Text:         "Next »",
+			CallbackData: generateCommandCallbackData(command, page+1),
+		})
+	}
+	// The arguments of the command may be too long to fit in the callback data.
+	for _, button := range buttons {
+		if len(button.CallbackData) > maxCallbackDataLength {
+			return nil
+		}
+	}
+	if len(buttons) == 0 {
+		return nil
+	}
+	return [][]telegram.InlineKeyboardButton{buttons}
+}
+
+// generateCommandCallbackData returns the callback data in the format "/<command> <page> <args>".
+func generateCommandCallbackData(command telegram.Command, page int) string {
+	return strings.TrimSpace(fmt.Sprintf("/%s %d %s", command.Name, page, command.Args))
+}
+
+func parseCommandCallbackData(data string) (telegram.Command, int, bool) {
+	name, rest, _ := strings.Cut(strings.TrimPrefix(data, "/"), " ")
+	pageString, args, _ := strings.Cut(rest, " ")
+	page, err := strconv.Atoi(pageString)
+	if name == "" || err != nil || page < 0 {
+		return telegram.Command{}, 0, false
+	}
+	return telegram.Command{Name: name, Args: args}, page, true
+}
@@ -0,0 +1,314 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/telegram"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/test/store"
+)
+
+func createTestingUser(ctx context.Context, t *testing.T, ts *store.Store, username string) *store.User {
+	user, err := ts.CreateUser(ctx, &store.User{
+		Username: username,
+		Role:     store.RoleUser,
+		Email:    username + "@test.com",
+	})
+	require.NoError(t, err)
+	return user
+}
+
+func createTestingMemo(ctx context.Context, t *testing.T, ts *store.Store, creatorID int32, content string) *store.Memo {
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		CreatorID:  creatorID,
+		Content:    content,
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	return memo
+}
+
+func TestRunCommandRecent(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	handler := NewTelegramHandler(ts)
+	user := createTestingUser(ctx, t, ts, "test")
+	otherUser := createTestingUser(ctx, t, ts, "other")
+	memos := []*store.Memo{}
+	for i := 1; i <= 7; i++ {
+		memos = append(memos, createTestingMemo(ctx, t, ts, user.ID, fmt.Sprintf("memo %d", i)))
+	}
+	otherMemo := createTestingMemo(ctx, t, ts, otherUser.ID, "other memo")
+	archivedStatus := store.Archived
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memos[6].ID, RowStatus: &archivedStatus}))
+
+	// The first page lists the latest memos of the user, without the archived ones and the ones of the others.
+	text, keyboard, err := handler.runCommand(ctx, user.ID, telegram.Command{Name: "recent"}, 0)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, "Recent memos (page 1):"))
+	for _, memo := range memos[1:6] {
+		require.Contains(t, text, fmt.Sprintf("Memo %d ·", memo.ID))
+	}
+	require.NotContains(t, text, fmt.Sprintf("Memo %d ·", memos[0].ID))
+	require.NotContains(t, text, fmt.Sprintf("Memo %d ·", memos[6].ID))
+	require.NotContains(t, text, fmt.Sprintf("Memo %d ·", otherMemo.ID))
+	require.Equal(t, [][]telegram.InlineKeyboardButton{{
+		{Text: "Next »", CallbackData: "/recent 1"},
+	}}, keyboard)
+
+	text, keyboard, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "recent"}, 1)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, "Recent memos (page 2):"))
+	require.Contains(t, text, fmt.Sprintf("Memo %d ·", memos[0].ID))
+	require.Equal(t, [][]telegram.InlineKeyboardButton{{
+		{Text: "« Prev", CallbackData: "/recent 0"},
+	}}, keyboard)
+
+	text, keyboard, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "recent"}, 2)
+	require.NoError(t, err)
+	require.Equal(t, "Recent memos: no more memos", text)
+	require.Equal(t, [][]telegram.InlineKeyboardButton{{
+		{Text: "« Prev", CallbackData: "/recent 1"},
+	}}, keyboard)
+
+	// The count is the page size, and it is capped.
+	text, keyboard, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "recent", Args: "2"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(text, "Memo "))
+	require.Equal(t, [][]telegram.InlineKeyboardButton{{
+		{Text: "Next »", CallbackData: "/recent 1 2"},
+	}}, keyboard)
+	text, keyboard, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "recent", Args: "100"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 6, strings.Count(text, "Memo "))
+	require.Nil(t, keyboard)
+
+	for _, args := range []string{"0", "-1", "abc"} {
+		text, keyboard, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "recent", Args: args}, 0)
+		require.NoError(t, err)
+		require.Equal(t, "Usage: /recent [n]", text)
+		require.Nil(t, keyboard)
+	}
+
+	text, _, err = handler.runCommand(ctx, createTestingUser(ctx, t, ts, "empty").ID, telegram.Command{Name: "recent"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Recent memos: no memos found", text)
+}
+
+func TestRunCommandSearch(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	handler := NewTelegramHandler(ts)
+	user := createTestingUser(ctx, t, ts, "test")
+	otherUser := createTestingUser(ctx, t, ts, "other")
+	memo := createTestingMemo(ctx, t, ts, user.ID, "Morning coffee with friends")
+	createTestingMemo(ctx, t, ts, user.ID, "Evening tea")
+	otherMemo := createTestingMemo(ctx, t, ts, otherUser.ID, "Afternoon coffee")
+
+	text, keyboard, err := handler.runCommand(ctx, user.ID, telegram.Command{Name: "search", Args: "coffee"}, 0)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, `Memos matching "coffee" (page 1):`))
+	require.Contains(t, text, fmt.Sprintf("Memo %d ·", memo.ID))
+	require.Contains(t, text, "Morning coffee with friends")
+	require.NotContains(t, text, fmt.Sprintf("Memo %d ·", otherMemo.ID))
+	require.NotContains(t, text, "Evening tea")
+	require.Nil(t, keyboard)
+
+	// The query supports the full-text search syntax.
+	text, _, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "search", Args: "coffee OR tea"}, 0)
+	require.NoError(t, err)
+	require.Contains(t, text, "Morning coffee with friends")
+	require.Contains(t, text, "Evening tea")
+	text, _, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "search", Args: "coffee NOT friends"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, `Memos matching "coffee NOT friends": no memos found`, text)
+
+	text, _, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "search", Args: "juice"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, `Memos matching "juice": no memos found`, text)
+
+	text, _, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "search"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Usage: /search <query>", text)
+}
+
+func TestRunCommandTag(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	handler := NewTelegramHandler(ts)
+	user := createTestingUser(ctx, t, ts, "test")
+	otherUser := createTestingUser(ctx, t, ts, "other")
+	memo := createTestingMemo(ctx, t, ts, user.ID, "Standup #work")
+	createTestingMemo(ctx, t, ts, user.ID, "Groceries #home")
+	otherMemo := createTestingMemo(ctx, t, ts, otherUser.ID, "Review #work")
+
+	for _, args := range []string{"work", "#work"} {
+		text, keyboard, err := handler.runCommand(ctx, user.ID, telegram.Command{Name: "tag", Args: args}, 0)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(text, "Memos with tag #work (page 1):"))
+		require.Contains(t, text, fmt.Sprintf("Memo %d ·", memo.ID))
+		require.NotContains(t, text, fmt.Sprintf("Memo %d ·", otherMemo.ID))
+		require.NotContains(t, text, "Groceries")
+		require.Nil(t, keyboard)
+	}
+
+	for _, args := range []string{"", "#", "work home"} {
+		text, _, err := handler.runCommand(ctx, user.ID, telegram.Command{Name: "tag", Args: args}, 0)
+		require.NoError(t, err)
+		require.Equal(t, "Usage: /tag <name>", text)
+	}
+}
+
+func TestRunCommandAppend(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	handler := NewTelegramHandler(ts)
+	user := createTestingUser(ctx, t, ts, "test")
+	otherUser := createTestingUser(ctx, t, ts, "other")
+	memo := createTestingMemo(ctx, t, ts, user.ID, "Todo\n")
+	otherMemo := createTestingMemo(ctx, t, ts, otherUser.ID, "Not yours")
+
+	text, keyboard, err := handler.runCommand(ctx, user.ID, telegram.Command{Name: "append", Args: fmt.Sprintf("%d buy milk", memo.ID)}, 0)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("Appended to PRIVATE Memo %d", memo.ID), text)
+	require.NotNil(t, keyboard)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "Todo\n\nbuy milk", memo.Content)
+
+	// The memos of the others are not found, and not changed.
+	text, keyboard, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "append", Args: fmt.Sprintf("%d buy milk", otherMemo.ID)}, 0)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("Memo %d not found", otherMemo.ID), text)
+	require.Nil(t, keyboard)
+	otherMemo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &otherMemo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "Not yours", otherMemo.Content)
+
+	text, _, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "append", Args: "9999 buy milk"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Memo 9999 not found", text)
+
+	for _, args := range []string{"", "abc buy milk", fmt.Sprintf("%d", memo.ID), fmt.Sprintf("%d   ", memo.ID)} {
+		text, _, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "append", Args: args}, 0)
+		require.NoError(t, err)
+		require.Equal(t, "Usage: /append <id> <text>", text)
+	}
+}
+
+func TestRunCommandRandom(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	handler := NewTelegramHandler(ts)
+	user := createTestingUser(ctx, t, ts, "test")
+	otherUser := createTestingUser(ctx, t, ts, "other")
+	createTestingMemo(ctx, t, ts, otherUser.ID, "Not yours")
+
+	text, keyboard, err := handler.runCommand(ctx, user.ID, telegram.Command{Name: "random"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, "No memos to review", text)
+	require.Nil(t, keyboard)
+
+	memo := createTestingMemo(ctx, t, ts, user.ID, "Remember this")
+	text, keyboard, err = handler.runCommand(ctx, user.ID, telegram.Command{Name: "random"}, 0)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, fmt.Sprintf("Review this memo:\n\nMemo %d ·", memo.ID)))
+	require.Contains(t, text, "Remember this")
+	require.Equal(t, [][]telegram.InlineKeyboardButton{{
+		{Text: "Another", CallbackData: "/random 0"},
+	}}, keyboard)
+}
+
+func TestRunCommandHelp(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	handler := NewTelegramHandler(ts)
+
+	text, _, err := handler.runCommand(ctx, 1, telegram.Command{Name: "help"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, helpMessage, text)
+	text, _, err = handler.runCommand(ctx, 1, telegram.Command{Name: "unknown"}, 0)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, "Unknown command /unknown"))
+}
+
+func TestParseCommandCallbackData(t *testing.T) {
+	tests := []struct {
+		data    string
+		command telegram.Command
+		page    int
+		ok      bool
+	}{
+		{
+			data:    "/recent 1",
+			command: telegram.Command{Name: "recent"},
+			page:    1,
+			ok:      true,
+		},
+		{
+			data:    "/search 2 coffee with friends",
+			command: telegram.Command{Name: "search", Args: "coffee with friends"},
+			page:    2,
+			ok:      true,
+		},
+		{
+			data:    "/tag 0 work",
+			command: telegram.Command{Name: "tag", Args: "work"},
+			page:    0,
+			ok:      true,
+		},
+		{
+			data: "/recent",
+			ok:   false,
+		},
+		{
+			data: "/recent abc",
+			ok:   false,
+		},
+		{
+			data: "/recent -1",
+			ok:   false,
+		},
+		{
+			data: "/ 1",
+			ok:   false,
+		},
+		{
+			data: "",
+			ok:   false,
+		},
+	}
+
+	for _, test := range tests {
+		command, page, ok := parseCommandCallbackData(test.data)
+		require.Equal(t, test.ok, ok, test.data)
+		require.Equal(t, test.command, command, test.data)
+		require.Equal(t, test.page, page, test.data)
+	}
+
+	// The callback data of the page keyboards are parsed back to their commands.
+	command := telegram.Command{Name: "search", Args: "coffee"}
+	for _, button := range generatePageKeyboard(command, 1, true)[0] {
+		parsedCommand, _, ok := parseCommandCallbackData(button.CallbackData)
+		require.True(t, ok)
+		require.Equal(t, command, parsedCommand)
+	}
+}
+
+func TestGeneratePageKeyboard(t *testing.T) {
+	command := telegram.Command{Name: "search", Args: "coffee"}
+	require.Nil(t, generatePageKeyboard(command, 0, false))
+	require.Equal(t, [][]telegram.InlineKeyboardButton{{
+		{Text: "« Prev", CallbackData: "/search 0 coffee"},
+		{Text: "Next »", CallbackData: "/search 2 coffee"},
+	}}, generatePageKeyboard(command, 1, true))
+
+	// The pages are not navigable when the arguments do not fit in the callback data.
+	command = telegram.Command{Name: "search", Args: strings.Repeat("coffee ", 10)}
+	require.Nil(t, generatePageKeyboard(command, 0, true))
+}
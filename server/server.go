@@ -21,6 +21,7 @@ import (
 	"github.com/usememos/memos/server/service/backup"
 	"github.com/usememos/memos/server/service/metric"
 	versionchecker "github.com/usememos/memos/server/service/version_checker"
+	webhookdispatcher "github.com/usememos/memos/server/service/webhook_dispatcher"
 	"github.com/usememos/memos/store"
 )
 
@@ -36,8 +37,9 @@ type Server struct {
 	apiV2Service *apiv2.APIV2Service
 
 	// Asynchronous runners.
-	backupRunner *backup.BackupRunner
-	telegramBot  *telegram.Bot
+	backupRunner      *backup.BackupRunner
+	telegramBot       *telegram.Bot
+	webhookDispatcher *webhookdispatcher.WebhookDispatcher
 }
 
 func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store) (*Server, error) {
@@ -52,7 +54,8 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 		Profile: profile,
 
 		// Asynchronous runners.
-		telegramBot: telegram.NewBotWithHandler(integration.NewTelegramHandler(store)),
+		telegramBot:       telegram.NewBotWithHandler(integration.NewTelegramHandler(store)),
+		webhookDispatcher: webhookdispatcher.NewWebhookDispatcher(store),
 	}
 
 	if profile.Driver == "sqlite" {
@@ -76,12 +79,36 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 		Timeout: 30 * time.Second,
 	}))
 
+	if s.backupRunner != nil {
+		// Hold the requests while the database is being restored.
+		// The v2 requests are held by the gRPC server, which serves the gateway and the direct connections too.
+		e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				if isBackupRestoreRequest(c) || isAPIV2Request(c) {
+					return next(c)
+				}
+				return store.Guard(func() error {
+					return next(c)
+				})
+			}
+		})
+	}
+
 	serverID, err := s.getSystemServerID(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve system server ID")
 	}
 	s.ID = serverID
 
+	// Index the tags of the memos created before the memo tag index.
+	if err := store.BackfillMemoTags(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to backfill memo tags")
+	}
+	// Move the favorite tags from the fav_tag user settings to the tag metadata.
+	if err := store.MigrateFavoriteTags(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to migrate favorite tags")
+	}
+
 	// Serve frontend.
 	embedFrontend(e)
 
@@ -106,10 +133,10 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 
 	// Register API v1 endpoints.
 	rootGroup := e.Group("")
-	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, s.telegramBot)
+	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, s.telegramBot, s.webhookDispatcher, s.backupRunner)
 	apiV1Service.Register(rootGroup)
 
-	s.apiV2Service = apiv2.NewAPIV2Service(s.Secret, profile, store, s.Profile.Port+1)
+	s.apiV2Service = apiv2.NewAPIV2Service(s.Secret, profile, store, s.Profile.Port+1, s.webhookDispatcher)
 	// Register gRPC gateway as api v2.
 	if err := s.apiV2Service.RegisterGateway(ctx, e); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -121,6 +148,7 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 func (s *Server) Start(ctx context.Context) error {
 	go versionchecker.NewVersionChecker(s.Store, s.Profile).Start(ctx)
 	go s.telegramBot.Start(ctx)
+	go s.webhookDispatcher.Run(ctx)
 
 	if s.backupRunner != nil {
 		go s.backupRunner.Run(ctx)
@@ -199,5 +227,23 @@ func timeoutSkipper(c echo.Context) bool {
 	}
 
 	// Skip timeout for blob upload which is frequently timed out.
-	return c.Request().Method == http.MethodPost && c.Request().URL.Path == "/api/v1/resource/blob"
+	if c.Request().Method == http.MethodPost && c.Request().URL.Path == "/api/v1/resource/blob" {
+		return true
+	}
+
+	// Skip timeout for export and import which stream the data of the whole user.
+	if c.Request().URL.Path == "/api/v1/export" || c.Request().URL.Path == "/api/v1/import" {
+		return true
+	}
+
+	// Skip timeout for backup restore which uploads and migrates the whole database.
+	return isBackupRestoreRequest(c)
+}
+
+func isAPIV2Request(c echo.Context) bool {
+	return grpcRequestSkipper(c) || strings.HasPrefix(c.Request().URL.Path, "/api/v2/")
+}
+
+func isBackupRestoreRequest(c echo.Context) bool {
+	return c.Request().Method == http.MethodPost && c.Request().URL.Path == "/api/v1/backup/restore"
 }
@@ -4,91 +4,362 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
 
-	apiv1 "github.com/usememos/memos/api/v1"
+	"github.com/usememos/memos/internal/cron"
 	"github.com/usememos/memos/internal/log"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
+const (
+	// DefaultMaxKeep is the number of backups kept when the backup config does not set max_keep.
+	DefaultMaxKeep = 5
+
+	backupJobID      = "backup"
+	backupDirName    = "backups"
+	backupTimeLayout = "20060102150405.000"
+
+	// legacyIntervalSettingName is the deprecated setting of the backup interval in seconds, which is migrated to the backup config.
+	legacyIntervalSettingName = "auto-backup-interval"
+)
+
+var (
+	// backupNameMatcher matches the backup file names with the creation time in milliseconds, e.g. "backup-20240102150405123.db".
+	backupNameMatcher = regexp.MustCompile(`^backup-\d{17}\.db$`)
+	// legacyBackupMatcher matches the rotated backups of the interval setting next to the database file, e.g. "memos_prod.db.bak.1".
+	legacyBackupMatcher = regexp.MustCompile(`\.bak(\.\d+)?$`)
+)
+
+// Backup is a backup file of the database.
+type Backup struct {
+	Name      string
+	Size      int64
+	CreatedTs int64
+}
+
+// BackupRunner creates the database backups on the schedule of the backup config,
+// and keeps the latest max_keep of them.
 // nolint
 type BackupRunner struct {
 	Store *store.Store
+
+	cron *cron.Cron
+	// mutex serializes the backups and the pruning of the old ones, so the scheduled backups wait for a restore too.
+	mutex sync.Mutex
 }
 
 func NewBackupRunner(store *store.Store) *BackupRunner {
+	c := cron.New()
+	// Cron expressions are written in the local time of the server.
+	c.SetTimezone(time.Local)
 	return &BackupRunner{
 		Store: store,
+		cron:  c,
 	}
 }
 
-const MaxBackupFiles = 5
-
 func (r *BackupRunner) Run(ctx context.Context) {
-	intervalStr := r.Store.GetSystemSettingValueWithDefault(ctx, apiv1.SystemSettingAutoBackupIntervalName.String(), "")
-	if intervalStr == "" {
-		log.Debug("no SystemSettingAutoBackupIntervalName setting, disable auto backup")
-		return
+	if err := r.MigrateLegacyConfig(ctx); err != nil {
+		log.Error("fail to migrate legacy backup config", zap.Error(err))
+	}
+	if err := r.Reload(ctx); err != nil {
+		log.Error("fail to load backup config", zap.Error(err))
+	}
+	r.cron.Start()
+	<-ctx.Done()
+	r.cron.Stop()
+	log.Info("stop auto backup graceful.")
+}
+
+// Reload schedules the backup job with the current backup config.
+// It should be called whenever the backup config is changed.
+func (r *BackupRunner) Reload(ctx context.Context) error {
+	r.cron.Remove(backupJobID)
+
+	backupConfig, err := GetBackupConfig(ctx, r.Store)
+	if err != nil {
+		return err
+	}
+	if !backupConfig.Enabled {
+		log.Debug("backup config is disabled, disable auto backup")
+		return nil
+	}
+
+	// The job runs after ctx is cancelled by the caller, e.g. a request, so it keeps its own context.
+	jobCtx := context.WithoutCancel(ctx)
+	if err := r.cron.Add(backupJobID, backupConfig.Cron, func() {
+		if _, err := r.CreateBackup(jobCtx); err != nil {
+			log.Error("fail to create backup", zap.Error(err))
+		}
+	}); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("enable auto backup with cron %q", backupConfig.Cron))
+	return nil
+}
+
+// MigrateLegacyConfig migrates the deprecated auto-backup-interval setting to the backup config, if the backup config is not set.
+// The rotated backups of the interval setting, i.e. "<dsn>.bak" and "<dsn>.bak.N", are moved to the backup directory
+// with the names of their modification time, so they are listed, restored and pruned with the others.
+func (r *BackupRunner) MigrateLegacyConfig(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.migrateLegacyBackups(); err != nil {
+		return errors.Wrap(err, "failed to migrate legacy backups")
 	}
 
+	intervalStr := r.Store.GetSystemSettingValueWithDefault(ctx, legacyIntervalSettingName, "")
+	if intervalStr == "" {
+		return nil
+	}
+	if r.Store.GetSystemSettingValueWithDefault(ctx, storepb.SystemSettingKey_BACKUP_CONFIG.String(), "") != "" {
+		return nil
+	}
 	interval, err := strconv.Atoi(intervalStr)
 	if err != nil || interval < 0 {
-		log.Error(fmt.Sprintf("invalid SystemSettingAutoBackupIntervalName value %s, disable auto backup", intervalStr), zap.Error(err))
-		return
+		return errors.Errorf("invalid %s value %s", legacyIntervalSettingName, intervalStr)
 	}
+	log.Info(fmt.Sprintf("migrate %s %d seconds to backup config", legacyIntervalSettingName, interval))
+	return SetBackupInterval(ctx, r.Store, interval)
+}
 
-	if interval == 0 {
-		println("AutoBackupIntervalName value is 0, disable auto backup")
-		return
+func (r *BackupRunner) migrateLegacyBackups() error {
+	filenames, err := filepath.Glob(r.Store.Profile.DSN + ".bak*")
+	if err != nil {
+		return err
 	}
+	for _, filename := range filenames {
+		if !legacyBackupMatcher.MatchString(filename) {
+			continue
+		}
+		fileInfo, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(r.backupDir(), os.ModePerm); err != nil {
+			return errors.Wrap(err, "failed to create backup directory")
+		}
 
-	log.Info("enable auto backup every " + intervalStr + " seconds")
-	ticker := time.NewTicker(time.Duration(interval) * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			log.Info("stop auto backup graceful.")
-			return
-		case <-ticker.C:
+		// The rotated backups may have the same modification time, which must not replace each other.
+		createdTime := fileInfo.ModTime()
+		name := getBackupName(createdTime)
+		for {
+			if _, err := os.Stat(filepath.Join(r.backupDir(), name)); os.IsNotExist(err) {
+				break
+			}
+			createdTime = createdTime.Add(time.Millisecond)
+			name = getBackupName(createdTime)
+		}
+		log.Info("move legacy backup", zap.String("from", filename), zap.String("to", name))
+		if err := os.Rename(filename, filepath.Join(r.backupDir(), name)); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		filename := r.Store.Profile.DSN + ".bak"
+// CreateBackup creates a backup of the database and removes the backups exceeding max_keep.
+func (r *BackupRunner) CreateBackup(ctx context.Context) (*Backup, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.createBackup(ctx)
+}
 
-		if err := rotateFiles(filename, MaxBackupFiles); err != nil {
-			log.Error("fail to rotate backup files", zap.Error(err))
-			continue
+func (r *BackupRunner) createBackup(ctx context.Context) (*Backup, error) {
+	backupConfig, err := GetBackupConfig(ctx, r.Store)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(r.backupDir(), os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "failed to create backup directory")
+	}
+	name := getBackupName(time.Now())
+	filename := filepath.Join(r.backupDir(), name)
+	// Never replace an existing backup, which may be the only copy of some data.
+	if _, err := os.Stat(filename); err == nil {
+		return nil, errors.Errorf("backup %s already exists", name)
+	}
+
+	log.Info(fmt.Sprintf("create backup to %s", filename))
+	if err := r.Store.BackupTo(ctx, filename); err != nil {
+		return nil, errors.Wrap(err, "failed to backup database")
+	}
+	backup, err := r.getBackup(name)
+	if err != nil {
+		return nil, err
+	}
+
+	maxKeep := int(backupConfig.MaxKeep)
+	if maxKeep <= 0 {
+		maxKeep = DefaultMaxKeep
+	}
+	if err := r.pruneBackups(maxKeep); err != nil {
+		return nil, errors.Wrap(err, "failed to remove old backups")
+	}
+	return backup, nil
+}
+
+// ListBackups returns the backups ordered from the latest.
+func (r *BackupRunner) ListBackups() ([]*Backup, error) {
+	entries, err := os.ReadDir(r.backupDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*Backup{}, nil
 		}
+		return nil, errors.Wrap(err, "failed to read backup directory")
+	}
 
-		log.Info(fmt.Sprintf("create backup to %s", filename))
-		if err := r.Store.BackupTo(ctx, filename); err != nil {
-			log.Error("fail to create backup", zap.Error(err))
+	backups := []*Backup{}
+	for _, entry := range entries {
+		if entry.IsDir() || !backupNameMatcher.MatchString(entry.Name()) {
+			continue
 		}
+		backup, err := r.getBackup(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, backup)
+	}
+	// The names contain the creation time, so they sort in time order.
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Name > backups[j].Name
+	})
+	return backups, nil
+}
+
+// GetBackupFilePath returns the file path of the backup with the given name.
+func (r *BackupRunner) GetBackupFilePath(name string) (string, error) {
+	if !backupNameMatcher.MatchString(name) {
+		return "", errors.Errorf("invalid backup name %q", name)
 	}
+	return filepath.Join(r.backupDir(), name), nil
 }
 
-func rotateFiles(filename string, cnt int) error {
-	// Generate suffix slices of history files like "",".1",".2",".3"...
-	ss := make([]string, cnt-1)
-	for i := 1; i < len(ss); i++ {
-		ss[i] = fmt.Sprintf(".%d", i)
+// DeleteBackup deletes the backup with the given name.
+func (r *BackupRunner) DeleteBackup(name string) error {
+	filename, err := r.GetBackupFilePath(name)
+	if err != nil {
+		return err
 	}
 
-	// Iterate through the suffix slices and rename the files
-	for i := len(ss) - 1; i >= 0; i-- {
-		from := filename + ss[i]
-		to := filename + "." + strconv.Itoa(i+1)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return os.Remove(filename)
+}
 
-		log.Info("rotate file", zap.String("from", from), zap.String("to", to))
-		err := os.Rename(from, to)
-		if err != nil && !os.IsNotExist(err) {
+func (r *BackupRunner) pruneBackups(maxKeep int) error {
+	backups, err := r.ListBackups()
+	if err != nil {
+		return err
+	}
+	if len(backups) <= maxKeep {
+		return nil
+	}
+
+	for _, backup := range backups[maxKeep:] {
+		log.Info("remove old backup", zap.String("name", backup.Name))
+		if err := os.Remove(filepath.Join(r.backupDir(), backup.Name)); err != nil && !os.IsNotExist(err) {
 			return err
 		}
 	}
+	return nil
+}
+
+func (r *BackupRunner) getBackup(name string) (*Backup, error) {
+	fileInfo, err := os.Stat(filepath.Join(r.backupDir(), name))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stat backup")
+	}
+	timestamp := name[len("backup-") : len(name)-len(".db")]
+	createdTime, err := time.Parse(backupTimeLayout, timestamp[:14]+"."+timestamp[14:])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse backup time")
+	}
+	return &Backup{
+		Name:      name,
+		Size:      fileInfo.Size(),
+		CreatedTs: createdTime.Unix(),
+	}, nil
+}
+
+// getBackupName returns the name of the backup created at the time.
+func getBackupName(createdTime time.Time) string {
+	return fmt.Sprintf("backup-%s.db", strings.Replace(createdTime.UTC().Format(backupTimeLayout), ".", "", 1))
+}
+
+// backupDir returns the directory of the backups, which is next to the database file.
+func (r *BackupRunner) backupDir() string {
+	return filepath.Join(filepath.Dir(r.Store.Profile.DSN), backupDirName)
+}
+
+// GetBackupConfig returns the backup config in the system settings, which is disabled if it is not set.
+func GetBackupConfig(ctx context.Context, s *store.Store) (*storepb.BackupConfig, error) {
+	backupConfig := &storepb.BackupConfig{}
+	value := s.GetSystemSettingValueWithDefault(ctx, storepb.SystemSettingKey_BACKUP_CONFIG.String(), "")
+	if value == "" {
+		return backupConfig, nil
+	}
+	if err := protojson.Unmarshal([]byte(value), backupConfig); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal backup config")
+	}
+	return backupConfig, nil
+}
 
+// SetBackupInterval sets the schedule of the backup config from an interval in seconds, which disables the backups if it is 0.
+// The cron expressions are in minutes, so the interval is rounded down to the nearest schedule they can express.
+func SetBackupInterval(ctx context.Context, s *store.Store, interval int) error {
+	backupConfig, err := GetBackupConfig(ctx, s)
+	if err != nil {
+		return err
+	}
+	backupConfig.Enabled = interval > 0
+	if interval > 0 {
+		backupConfig.Cron = convertIntervalToCron(interval)
+	}
+	value, err := protojson.Marshal(backupConfig)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal backup config")
+	}
+	if _, err := s.UpsertSystemSetting(ctx, &store.SystemSetting{
+		Name:  storepb.SystemSettingKey_BACKUP_CONFIG.String(),
+		Value: string(value),
+	}); err != nil {
+		return errors.Wrap(err, "failed to upsert backup config")
+	}
 	return nil
 }
+
+func convertIntervalToCron(interval int) string {
+	minutes := max(interval/60, 1)
+	if minutes < 60 {
+		return fmt.Sprintf("*/%d * * * *", getLargestDivisor(60, minutes))
+	}
+	hours := minutes / 60
+	if hours < 24 {
+		return fmt.Sprintf("0 */%d * * *", getLargestDivisor(24, hours))
+	}
+	return "0 0 * * *"
+}
+
+// getLargestDivisor returns the largest divisor of n that is not greater than limit.
+func getLargestDivisor(n, limit int) int {
+	for divisor := limit; divisor > 1; divisor-- {
+		if n%divisor == 0 {
+			return divisor
+		}
+	}
+	return 1
+}
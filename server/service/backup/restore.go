@@ -0,0 +1,130 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/log"
+	"github.com/usememos/memos/server/version"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+// ErrInvalidBackup is returned when the backup file to restore is not a valid database of memos.
+var ErrInvalidBackup = errors.New("invalid backup")
+
+// RestoreBackup replaces the database with the given backup file.
+// The backup is validated and migrated on a copy first, so an invalid backup leaves the database untouched
+// and returns ErrInvalidBackup. Then the guarded database accesses are held by Store.Quiesce
+// while a backup of the current database is created and the copy is swapped in.
+func (r *BackupRunner) RestoreBackup(ctx context.Context, filename string) error {
+	if err := os.MkdirAll(r.backupDir(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to create backup directory")
+	}
+	restoreFilename := filepath.Join(r.backupDir(), fmt.Sprintf("restore-%d.db", time.Now().UnixNano()))
+	defer removeDatabaseFiles(restoreFilename)
+	if err := copyFile(filename, restoreFilename); err != nil {
+		return errors.Wrap(err, "failed to copy backup")
+	}
+	if err := prepareRestore(ctx, r.Store, restoreFilename); err != nil {
+		return err
+	}
+
+	return r.Store.Quiesce(func() error {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		// Keep the current database, so an unwanted restore can be reverted.
+		backup, err := r.createBackup(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to backup current database")
+		}
+		log.Info(fmt.Sprintf("backup current database to %s before restore", backup.Name))
+
+		if err := r.Store.RestoreFrom(ctx, restoreFilename); err != nil {
+			return errors.Wrap(err, "failed to restore database")
+		}
+		log.Info(fmt.Sprintf("restore database from %s", filename))
+
+		// The restored database may have another backup config.
+		if err := r.Reload(ctx); err != nil {
+			return errors.Wrap(err, "failed to reload backup config")
+		}
+		return nil
+	})
+}
+
+// prepareRestore validates the database file as a backup of memos, and migrates it to the current version.
+func prepareRestore(ctx context.Context, s *store.Store, filename string) error {
+	profile := *s.Profile
+	profile.DSN = filename
+	driver, err := db.NewDBDriver(&profile)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidBackup, "failed to open backup, %v", err)
+	}
+	defer driver.Close()
+
+	var integrity string
+	if err := driver.GetDB().QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&integrity); err != nil {
+		return errors.Wrapf(ErrInvalidBackup, "not a valid database, %v", err)
+	}
+	if integrity != "ok" {
+		return errors.Wrapf(ErrInvalidBackup, "corrupted database, %s", integrity)
+	}
+
+	migrationHistoryList, err := driver.FindMigrationHistoryList(ctx, &store.FindMigrationHistory{})
+	if err != nil {
+		return errors.Wrapf(ErrInvalidBackup, "not a memos database, %v", err)
+	}
+	if len(migrationHistoryList) > 0 {
+		migrationHistoryVersionList := []string{}
+		for _, migrationHistory := range migrationHistoryList {
+			migrationHistoryVersionList = append(migrationHistoryVersionList, migrationHistory.Version)
+		}
+		sort.Sort(version.SortVersion(migrationHistoryVersionList))
+		latestMigrationHistoryVersion := migrationHistoryVersionList[len(migrationHistoryVersionList)-1]
+		schemaVersion := version.GetSchemaVersion(version.GetCurrentVersion(profile.Mode))
+		if version.IsVersionGreaterThan(latestMigrationHistoryVersion, schemaVersion) {
+			return errors.Wrapf(ErrInvalidBackup, "version %s is newer than the current version %s", latestMigrationHistoryVersion, schemaVersion)
+		}
+	}
+
+	if err := driver.Migrate(ctx); err != nil {
+		return errors.Wrapf(ErrInvalidBackup, "failed to migrate backup, %v", err)
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	dstFile, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dstFile, srcFile); err != nil {
+		dstFile.Close()
+		return err
+	}
+	return dstFile.Close()
+}
+
+// removeDatabaseFiles removes the database file with its journal files.
+func removeDatabaseFiles(filename string) {
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		if err := os.Remove(filename + suffix); err != nil && !os.IsNotExist(err) {
+			log.Warn(fmt.Sprintf("failed to remove %s", filename+suffix))
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package webhookdispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+
+	"github.com/usememos/memos/internal/log"
+	"github.com/usememos/memos/plugin/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// MaxAttempts is the number of attempts made for a delivery before giving up.
+	MaxAttempts = 5
+	// retryBaseDelay is the delay before the first retry, which doubles for every following retry.
+	retryBaseDelay = 30 * time.Second
+	// pollInterval is the interval to look for the due retries.
+	pollInterval = 10 * time.Second
+	// maxResponseBodyLength limits the response body kept in the delivery log.
+	maxResponseBodyLength = 4096
+	// maxConcurrentWebhooks limits the webhooks delivered at the same time.
+	maxConcurrentWebhooks = 8
+)
+
+// WebhookDispatcher posts the webhook payloads in the background.
+// The payloads are persisted as pending deliveries first, so they survive restarts
+// and a slow receiver does not block the request that triggers the event.
+// The deliveries of a webhook are sent one at a time in the event order, and the webhooks in parallel,
+// so a slow receiver only delays its own deliveries.
+// nolint
+type WebhookDispatcher struct {
+	Store *store.Store
+
+	notify chan struct{}
+	// workers holds a slot for every webhook being delivered.
+	workers chan struct{}
+	// mutex guards inflight.
+	mutex sync.Mutex
+	// inflight are the ids of the webhooks being delivered, which are skipped until their worker finishes.
+	inflight map[int32]bool
+	wg       sync.WaitGroup
+}
+
+func NewWebhookDispatcher(store *store.Store) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		Store:    store,
+		notify:   make(chan struct{}, 1),
+		workers:  make(chan struct{}, maxConcurrentWebhooks),
+		inflight: map[int32]bool{},
+	}
+}
+
+// Enqueue persists a pending delivery of the payload to the webhook.
+func (d *WebhookDispatcher) Enqueue(ctx context.Context, hook *storepb.Webhook, payload *webhook.WebhookPayload) (*store.WebhookDelivery, error) {
+	payload.URL = hook.Url
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal webhook payload")
+	}
+
+	delivery, err := d.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		WebhookID:     hook.Id,
+		ActivityType:  payload.ActivityType,
+		Payload:       string(body),
+		Attempt:       1,
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook delivery")
+	}
+	d.wakeUp()
+	return delivery, nil
+}
+
+// DispatchMemoEvent enqueues the memo event payload to the webhooks of the memo creator, which match it.
+func (d *WebhookDispatcher) DispatchMemoEvent(ctx context.Context, payload *webhook.WebhookPayload) error {
+	webhooks, err := d.Store.ListWebhooks(ctx, &store.FindWebhook{
+		CreatorID: &payload.CreatorID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list webhooks")
+	}
+	for _, hook := range webhooks {
+		if hook.RowStatus == storepb.RowStatus_ARCHIVED {
+			continue
+		}
+		if !matchWebhookFilter(hook.Filter, payload) {
+			continue
+		}
+		hookPayload := *payload
+		if _, err := d.Enqueue(ctx, hook, &hookPayload); err != nil {
+			return errors.Wrap(err, "failed to enqueue webhook")
+		}
+	}
+	return nil
+}
+
+// matchWebhookFilter returns whether the memo event should be posted to the webhook with the filter.
+func matchWebhookFilter(filter *storepb.WebhookFilter, payload *webhook.WebhookPayload) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.EventTypes) > 0 && !slices.Contains(filter.EventTypes, payload.ActivityType) {
+		return false
+	}
+	if len(filter.Visibilities) > 0 && !slices.Contains(filter.Visibilities, payload.Memo.Visibility) {
+		return false
+	}
+	if len(filter.Tags) > 0 {
+		tags, err := store.ExtractMemoTags(payload.Memo.Content)
+		if err != nil {
+			return false
+		}
+		matched := false
+		for _, tag := range tags {
+			for _, filterTag := range filter.Tags {
+				// A parent tag also matches its nested tags, e.g. `work` matches `work/meeting`.
+				if tag == filterTag || strings.HasPrefix(tag, filterTag+"/") {
+					matched = true
+				}
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// Redeliver persists a new pending delivery with the payload of the given delivery.
+func (d *WebhookDispatcher) Redeliver(ctx context.Context, delivery *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	redelivery, err := d.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		WebhookID:     delivery.WebhookID,
+		ActivityType:  delivery.ActivityType,
+		Payload:       delivery.Payload,
+		Attempt:       1,
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook delivery")
+	}
+	d.wakeUp()
+	return redelivery, nil
+}
+
+func (d *WebhookDispatcher) wakeUp() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.startPending(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.notify:
+		}
+	}
+}
+
+// DispatchPending sends all the pending deliveries which are due, and waits for them to finish.
+func (d *WebhookDispatcher) DispatchPending(ctx context.Context) {
+	d.startPending(ctx)
+	d.wg.Wait()
+}
+
+// startPending starts a worker for every webhook with due pending deliveries, unless it is already being delivered.
+// The webhooks left without a worker are picked up when a running one finishes.
+func (d *WebhookDispatcher) startPending(ctx context.Context) {
+	pendingStatus := store.WebhookDeliveryPending
+	now := time.Now().Unix()
+	var deliveries []*store.WebhookDelivery
+	err := d.Store.Guard(func() error {
+		var err error
+		deliveries, err = d.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+			Status:              &pendingStatus,
+			NextAttemptTsBefore: &now,
+		})
+		return err
+	})
+	if err != nil {
+		log.Error("failed to list pending webhook deliveries", zap.Error(err))
+		return
+	}
+
+	// Deliveries are listed latest first, so they are grouped in reverse to keep the event order.
+	webhookIDs := []int32{}
+	webhookDeliveries := map[int32][]*store.WebhookDelivery{}
+	for i := len(deliveries) - 1; i >= 0; i-- {
+		webhookID := deliveries[i].WebhookID
+		if _, ok := webhookDeliveries[webhookID]; !ok {
+			webhookIDs = append(webhookIDs, webhookID)
+		}
+		webhookDeliveries[webhookID] = append(webhookDeliveries[webhookID], deliveries[i])
+	}
+
+	for _, webhookID := range webhookIDs {
+		d.mutex.Lock()
+		if d.inflight[webhookID] {
+			d.mutex.Unlock()
+			continue
+		}
+		select {
+		case d.workers <- struct{}{}:
+		default:
+			d.mutex.Unlock()
+			return
+		}
+		d.inflight[webhookID] = true
+		d.mutex.Unlock()
+
+		d.wg.Add(1)
+		go func(webhookID int32, deliveries []*store.WebhookDelivery) {
+			defer d.wg.Done()
+			for _, delivery := range deliveries {
+				if ctx.Err() != nil {
+					break
+				}
+				if err := d.dispatch(ctx, delivery); err != nil {
+					log.Error(fmt.Sprintf("failed to dispatch webhook delivery %d", delivery.ID), zap.Error(err))
+				}
+			}
+
+			d.mutex.Lock()
+			delete(d.inflight, webhookID)
+			d.mutex.Unlock()
+			<-d.workers
+			// The deliveries enqueued meanwhile are due now.
+			d.wakeUp()
+		}(webhookID, webhookDeliveries[webhookID])
+	}
+}
+
+// dispatch posts the delivery and logs its result. The database accesses wait for a database restore
+// with Store.Guard, while the request to the webhook does not hold the restore.
+func (d *WebhookDispatcher) dispatch(ctx context.Context, delivery *store.WebhookDelivery) error {
+	var hook *storepb.Webhook
+	if err := d.Store.Guard(func() error {
+		var err error
+		hook, err = d.Store.GetWebhooks(ctx, &store.FindWebhook{
+			ID: &delivery.WebhookID,
+		})
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to get webhook")
+	}
+
+	update := &store.UpdateWebhookDelivery{
+		ID: delivery.ID,
+	}
+	var deliveryErr error
+	// The deliveries of the archived and deleted webhooks are cancelled without retries.
+	canceled := hook == nil || hook.RowStatus != storepb.RowStatus_NORMAL
+	if hook == nil {
+		deliveryErr = errors.Errorf("webhook %d not found", delivery.WebhookID)
+	} else if canceled {
+		deliveryErr = errors.Errorf("webhook %d is archived", delivery.WebhookID)
+	} else {
+		result, err := webhook.Deliver(hook.Url, []byte(delivery.Payload), hook.Secret)
+		if err != nil {
+			deliveryErr = err
+		} else {
+			statusCode := int32(result.StatusCode)
+			latencyMs := result.Latency.Milliseconds()
+			responseBody := truncateResponseBody(string(result.Body))
+			update.StatusCode = &statusCode
+			update.LatencyMs = &latencyMs
+			update.ResponseBody = &responseBody
+			deliveryErr = result.Check()
+		}
+	}
+
+	status := store.WebhookDeliverySucceeded
+	if deliveryErr != nil {
+		status = store.WebhookDeliveryFailed
+		if update.ResponseBody == nil {
+			// Keep the error as the response body when there is no response at all.
+			responseBody := truncateResponseBody(deliveryErr.Error())
+			update.ResponseBody = &responseBody
+		}
+	}
+	updatedTs := time.Now().Unix()
+	update.Status = &status
+	update.UpdatedTs = &updatedTs
+	return d.Store.Guard(func() error {
+		if _, err := d.Store.UpdateWebhookDelivery(ctx, update); err != nil {
+			return errors.Wrap(err, "failed to update webhook delivery")
+		}
+
+		if deliveryErr != nil && !canceled && delivery.Attempt < MaxAttempts {
+			if _, err := d.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+				WebhookID:     delivery.WebhookID,
+				ActivityType:  delivery.ActivityType,
+				Payload:       delivery.Payload,
+				Attempt:       delivery.Attempt + 1,
+				Status:        store.WebhookDeliveryPending,
+				NextAttemptTs: time.Now().Add(GetRetryDelay(delivery.Attempt)).Unix(),
+			}); err != nil {
+				return errors.Wrap(err, "failed to create webhook delivery retry")
+			}
+		}
+		return nil
+	})
+}
+
+// GetRetryDelay returns the delay before retrying the given failed attempt with exponential backoff.
+func GetRetryDelay(attempt int32) time.Duration {
+	return retryBaseDelay << (attempt - 1)
+}
+
+func truncateResponseBody(body string) string {
+	if len(body) > maxResponseBodyLength {
+		body = body[:maxResponseBodyLength]
+	}
+	// The body may be cut in the middle of a character, or not be text at all.
+	return strings.ToValidUTF8(body, "")
+}
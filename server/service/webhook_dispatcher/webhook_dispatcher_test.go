@@ -0,0 +1,101 @@
+package webhookdispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestMatchWebhookFilter(t *testing.T) {
+	tests := []struct {
+		filter  *storepb.WebhookFilter
+		payload *webhook.WebhookPayload
+		matched bool
+	}{
+		{
+			filter:  nil,
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Hello", Visibility: "PRIVATE"}},
+			matched: true,
+		},
+		{
+			filter:  &storepb.WebhookFilter{},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.updated", Memo: &webhook.Memo{Content: "Hello", Visibility: "PRIVATE"}},
+			matched: true,
+		},
+		{
+			filter:  &storepb.WebhookFilter{EventTypes: []string{"memos.memo.created"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Hello", Visibility: "PUBLIC"}},
+			matched: true,
+		},
+		{
+			filter:  &storepb.WebhookFilter{EventTypes: []string{"memos.memo.created"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.updated", Memo: &webhook.Memo{Content: "Hello", Visibility: "PUBLIC"}},
+			matched: false,
+		},
+		{
+			filter:  &storepb.WebhookFilter{Visibilities: []string{"PUBLIC", "PROTECTED"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Hello", Visibility: "PROTECTED"}},
+			matched: true,
+		},
+		{
+			filter:  &storepb.WebhookFilter{Visibilities: []string{"PUBLIC"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Hello", Visibility: "PRIVATE"}},
+			matched: false,
+		},
+		{
+			filter:  &storepb.WebhookFilter{Tags: []string{"work"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Standup #work", Visibility: "PRIVATE"}},
+			matched: true,
+		},
+		{
+			filter:  &storepb.WebhookFilter{Tags: []string{"work"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Standup #work/meeting", Visibility: "PRIVATE"}},
+			matched: true,
+		},
+		{
+			filter:  &storepb.WebhookFilter{Tags: []string{"work"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Standup #workout", Visibility: "PRIVATE"}},
+			matched: false,
+		},
+		{
+			filter:  &storepb.WebhookFilter{Tags: []string{"work/meeting"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Standup #work", Visibility: "PRIVATE"}},
+			matched: false,
+		},
+		{
+			filter:  &storepb.WebhookFilter{Tags: []string{"home", "work"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "Groceries #home", Visibility: "PRIVATE"}},
+			matched: true,
+		},
+		{
+			filter:  &storepb.WebhookFilter{Tags: []string{"work"}},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.created", Memo: &webhook.Memo{Content: "No tags here", Visibility: "PRIVATE"}},
+			matched: false,
+		},
+		{
+			filter: &storepb.WebhookFilter{
+				EventTypes:   []string{"memos.memo.updated"},
+				Visibilities: []string{"PUBLIC"},
+				Tags:         []string{"work"},
+			},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.updated", Memo: &webhook.Memo{Content: "Standup #work", Visibility: "PUBLIC"}},
+			matched: true,
+		},
+		{
+			filter: &storepb.WebhookFilter{
+				EventTypes:   []string{"memos.memo.updated"},
+				Visibilities: []string{"PUBLIC"},
+				Tags:         []string{"work"},
+			},
+			payload: &webhook.WebhookPayload{ActivityType: "memos.memo.updated", Memo: &webhook.Memo{Content: "Standup #work", Visibility: "PRIVATE"}},
+			matched: false,
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.matched, matchWebhookFilter(test.filter, test.payload))
+	}
+}
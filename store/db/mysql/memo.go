@@ -38,6 +38,21 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 }
 
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+	if find.SearchQuery != nil {
+		return nil, errors.Wrap(store.ErrMemoSearchNotSupported, "mysql")
+	}
+	if find.Filter != nil {
+		// The filters are not compiled to SQL here, so only the simple ones lowered to the other fields are supported.
+		lowered, matchable, err := store.LowerMemoFilter(find)
+		if err != nil {
+			return nil, err
+		}
+		if !matchable {
+			return []*store.Memo{}, nil
+		}
+		find = lowered
+	}
+
 	where, args := []string{"1 = 1"}, []any{}
 
 	if v := find.ID; v != nil {
@@ -63,6 +78,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, "%"+s+"%")
 		}
 	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, "EXISTS (SELECT 1 FROM `memo_tag` WHERE `memo_tag`.`memo_id` = `memo`.`id` AND (`memo_tag`.`tag` = ? OR `memo_tag`.`tag` IN (SELECT `tag_alias`.`tag` FROM `tag_alias` WHERE `tag_alias`.`creator_id` = `memo`.`creator_id` AND `tag_alias`.`alias` = ?)))"), append(args, *v, *v)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
@@ -0,0 +1,120 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoRelationTypeDefinition(ctx context.Context, create *store.MemoRelationTypeDefinition) (*store.MemoRelationTypeDefinition, error) {
+	stmt := "INSERT INTO `memo_relation_type` (`creator_id`, `name`, `inverse_name`, `directed`) VALUES (?, ?, ?, ?)"
+	result, err := d.db.ExecContext(ctx, stmt, create.CreatorID, create.Name, create.InverseName, create.Directed)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	return d.getMemoRelationTypeDefinition(ctx, id32)
+}
+
+func (d *DB) ListMemoRelationTypeDefinitions(ctx context.Context, find *store.FindMemoRelationTypeDefinition) ([]*store.MemoRelationTypeDefinition, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `creator_id`, `name`, `inverse_name`, `directed` FROM `memo_relation_type` WHERE "+strings.Join(where, " AND ")+" ORDER BY `name` ASC",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoRelationTypeDefinition{}
+	for rows.Next() {
+		definition := &store.MemoRelationTypeDefinition{}
+		if err := rows.Scan(
+			&definition.ID,
+			&definition.CreatedTs,
+			&definition.CreatorID,
+			&definition.Name,
+			&definition.InverseName,
+			&definition.Directed,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, definition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) getMemoRelationTypeDefinition(ctx context.Context, id int32) (*store.MemoRelationTypeDefinition, error) {
+	list, err := d.ListMemoRelationTypeDefinitions(ctx, &store.FindMemoRelationTypeDefinition{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return list[0], nil
+}
+
+func (d *DB) UpdateMemoRelationTypeDefinition(ctx context.Context, update *store.UpdateMemoRelationTypeDefinition) (*store.MemoRelationTypeDefinition, error) {
+	set, args := []string{}, []any{}
+	if update.InverseName != nil {
+		set, args = append(set, "`inverse_name` = ?"), append(args, *update.InverseName)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `memo_relation_type` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	return d.getMemoRelationTypeDefinition(ctx, update.ID)
+}
+
+func (d *DB) DeleteMemoRelationTypeDefinition(ctx context.Context, delete *store.DeleteMemoRelationTypeDefinition) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The relations of a custom type are only between the memos of its creator.
+	if _, err := tx.ExecContext(ctx, "DELETE `memo_relation` FROM `memo_relation` JOIN `memo` ON `memo`.`id` = `memo_relation`.`memo_id` JOIN `memo_relation_type` ON `memo_relation_type`.`name` = `memo_relation`.`type` AND `memo_relation_type`.`creator_id` = `memo`.`creator_id` WHERE `memo_relation_type`.`id` = ?", delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_relation_type` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func vacuumMemoRelationType(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `memo_relation_type` WHERE `creator_id` NOT IN (SELECT `id` FROM `user`)"
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -13,6 +13,12 @@ DROP TABLE IF EXISTS `storage`;
 DROP TABLE IF EXISTS `idp`;
 DROP TABLE IF EXISTS `inbox`;
 DROP TABLE IF EXISTS `webhook`;
+DROP TABLE IF EXISTS `memo_revision`;
+DROP TABLE IF EXISTS `webhook_delivery`;
+DROP TABLE IF EXISTS `saved_search`;
+DROP TABLE IF EXISTS `memo_tag`;
+DROP TABLE IF EXISTS `tag_alias`;
+DROP TABLE IF EXISTS `memo_relation_type`;
 
 -- migration_history
 CREATE TABLE `migration_history` (
@@ -49,6 +55,8 @@ CREATE TABLE `user_setting` (
   UNIQUE(`user_id`,`key`)
 );
 
+CREATE INDEX `idx_user_setting_key_value` ON `user_setting` (`key`, `value`(255));
+
 -- memo
 CREATE TABLE `memo` (
   `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -95,6 +103,7 @@ CREATE TABLE `resource` (
 CREATE TABLE `tag` (
   `name` VARCHAR(256) NOT NULL,
   `creator_id` INT NOT NULL,
+  `payload` TEXT NOT NULL,
   UNIQUE(`name`,`creator_id`)
 );
 
@@ -143,5 +152,80 @@ CREATE TABLE `webhook` (
   `row_status` VARCHAR(256) NOT NULL DEFAULT 'NORMAL',
   `creator_id` INT NOT NULL,
   `name` TEXT NOT NULL,
-  `url` TEXT NOT NULL
+  `url` TEXT NOT NULL,
+  `filter` TEXT NOT NULL,
+  `secret` TEXT NOT NULL
+);
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_memo_revision_memo_id` ON `memo_revision` (`memo_id`);
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `webhook_id` INT NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL,
+  `payload` LONGTEXT NOT NULL,
+  `attempt` INT NOT NULL DEFAULT 1,
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `next_attempt_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `status_code` INT NOT NULL DEFAULT 0,
+  `latency_ms` BIGINT NOT NULL DEFAULT 0,
+  `response_body` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_webhook_delivery_webhook_id` ON `webhook_delivery` (`webhook_id`);
+
+CREATE INDEX `idx_webhook_delivery_status` ON `webhook_delivery` (`status`, `next_attempt_ts`);
+
+-- saved_search
+CREATE TABLE `saved_search` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `filter` TEXT NOT NULL,
+  `order_by` VARCHAR(256) NOT NULL DEFAULT 'ORDER_UNSPECIFIED',
+  `pinned_position` INT NOT NULL DEFAULT 0,
+  UNIQUE(`creator_id`,`name`)
+);
+
+-- memo_tag
+CREATE TABLE `memo_tag` (
+  `memo_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  `implicit` INT NOT NULL DEFAULT 0,
+  UNIQUE(`memo_id`,`tag`)
+);
+
+CREATE INDEX `idx_memo_tag_tag` ON `memo_tag` (`tag`);
+
+-- tag_alias
+CREATE TABLE `tag_alias` (
+  `creator_id` INT NOT NULL,
+  `alias` VARCHAR(256) NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  UNIQUE(`creator_id`,`alias`)
+);
+
+-- memo_relation_type
+CREATE TABLE `memo_relation_type` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `inverse_name` VARCHAR(256) NOT NULL DEFAULT '',
+  `directed` INT NOT NULL DEFAULT 1,
+  UNIQUE(`creator_id`,`name`)
 );
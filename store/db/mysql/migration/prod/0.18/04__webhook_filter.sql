@@ -0,0 +1,3 @@
+ALTER TABLE `webhook` ADD COLUMN `filter` TEXT NOT NULL;
+
+UPDATE `webhook` SET `filter` = '{}';
@@ -0,0 +1,21 @@
+ALTER TABLE `webhook` ADD COLUMN `secret` TEXT NOT NULL;
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `webhook_id` INT NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL,
+  `payload` LONGTEXT NOT NULL,
+  `attempt` INT NOT NULL DEFAULT 1,
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `next_attempt_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `status_code` INT NOT NULL DEFAULT 0,
+  `latency_ms` BIGINT NOT NULL DEFAULT 0,
+  `response_body` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_webhook_delivery_webhook_id` ON `webhook_delivery` (`webhook_id`);
+
+CREATE INDEX `idx_webhook_delivery_status` ON `webhook_delivery` (`status`, `next_attempt_ts`);
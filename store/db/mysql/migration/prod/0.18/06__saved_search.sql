@@ -0,0 +1,12 @@
+-- saved_search
+CREATE TABLE `saved_search` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `filter` TEXT NOT NULL,
+  `order_by` VARCHAR(256) NOT NULL DEFAULT 'ORDER_UNSPECIFIED',
+  `pinned_position` INT NOT NULL DEFAULT 0,
+  UNIQUE(`creator_id`,`name`)
+);
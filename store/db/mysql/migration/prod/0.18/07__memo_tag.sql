@@ -0,0 +1,9 @@
+-- memo_tag
+CREATE TABLE `memo_tag` (
+  `memo_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  `implicit` INT NOT NULL DEFAULT 0,
+  UNIQUE(`memo_id`,`tag`)
+);
+
+CREATE INDEX `idx_memo_tag_tag` ON `memo_tag` (`tag`);
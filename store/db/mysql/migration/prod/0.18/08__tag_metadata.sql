@@ -0,0 +1,11 @@
+ALTER TABLE `tag` ADD COLUMN `payload` TEXT NOT NULL;
+
+UPDATE `tag` SET `payload` = '{}';
+
+-- tag_alias
+CREATE TABLE `tag_alias` (
+  `creator_id` INT NOT NULL,
+  `alias` VARCHAR(256) NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  UNIQUE(`creator_id`,`alias`)
+);
@@ -0,0 +1,10 @@
+-- memo_relation_type
+CREATE TABLE `memo_relation_type` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `inverse_name` VARCHAR(256) NOT NULL DEFAULT '',
+  `directed` INT NOT NULL DEFAULT 1,
+  UNIQUE(`creator_id`,`name`)
+);
@@ -0,0 +1,2 @@
+-- user_setting
+CREATE INDEX `idx_user_setting_key_value` ON `user_setting` (`key`, `value`(255));
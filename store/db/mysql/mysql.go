@@ -67,7 +67,25 @@ func (d *DB) Vacuum(ctx context.Context) error {
 	if err := vacuumMemoRelations(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumMemoRevision(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumWebhookDelivery(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumTag(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumSavedSearch(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoTag(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumTagAlias(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoRelationType(ctx, tx); err != nil {
 		// Prevent revive warning.
 		return err
 	}
@@ -79,6 +97,10 @@ func (*DB) BackupTo(context.Context, string) error {
 	return errors.New("Please use mysqldump to backup")
 }
 
+func (*DB) RestoreFrom(context.Context, string) error {
+	return errors.New("Please use mysql to restore")
+}
+
 func (d *DB) GetCurrentDBSize(ctx context.Context) (int64, error) {
 	query := "SELECT SUM(`data_length` + `index_length`) AS `size` " +
 		" FROM information_schema.TABLES" +
@@ -0,0 +1,136 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateSavedSearch(ctx context.Context, create *storepb.SavedSearch) (*storepb.SavedSearch, error) {
+	fields := []string{"`creator_id`", "`name`", "`filter`", "`order_by`", "`pinned_position`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.CreatorId, create.Name, create.Filter, create.Order.String(), create.PinnedPosition}
+
+	stmt := "INSERT INTO `saved_search` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	return d.GetSavedSearch(ctx, &store.FindSavedSearch{ID: &id32})
+}
+
+func (d *DB) ListSavedSearches(ctx context.Context, find *store.FindSavedSearch) ([]*storepb.SavedSearch, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `creator_id`, `name`, `filter`, `order_by`, `pinned_position` FROM `saved_search` WHERE "+strings.Join(where, " AND ")+" ORDER BY `pinned_position` = 0, `pinned_position` ASC, `name` ASC",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*storepb.SavedSearch{}
+	for rows.Next() {
+		savedSearch := &storepb.SavedSearch{}
+		var order string
+		if err := rows.Scan(
+			&savedSearch.Id,
+			&savedSearch.CreatedTs,
+			&savedSearch.UpdatedTs,
+			&savedSearch.CreatorId,
+			&savedSearch.Name,
+			&savedSearch.Filter,
+			&order,
+			&savedSearch.PinnedPosition,
+		); err != nil {
+			return nil, err
+		}
+		savedSearch.Order = storepb.SavedSearch_Order(storepb.SavedSearch_Order_value[order])
+		list = append(list, savedSearch)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) GetSavedSearch(ctx context.Context, find *store.FindSavedSearch) (*storepb.SavedSearch, error) {
+	list, err := d.ListSavedSearches(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (d *DB) UpdateSavedSearch(ctx context.Context, update *store.UpdateSavedSearch) (*storepb.SavedSearch, error) {
+	set, args := []string{}, []any{}
+	if update.UpdatedTs != nil {
+		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *update.UpdatedTs)
+	}
+	if update.Name != nil {
+		set, args = append(set, "`name` = ?"), append(args, *update.Name)
+	}
+	if update.Filter != nil {
+		set, args = append(set, "`filter` = ?"), append(args, *update.Filter)
+	}
+	if update.Order != nil {
+		set, args = append(set, "`order_by` = ?"), append(args, update.Order.String())
+	}
+	if update.PinnedPosition != nil {
+		set, args = append(set, "`pinned_position` = ?"), append(args, *update.PinnedPosition)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `saved_search` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	savedSearch, err := d.GetSavedSearch(ctx, &store.FindSavedSearch{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return savedSearch, nil
+}
+
+func (d *DB) DeleteSavedSearch(ctx context.Context, delete *store.DeleteSavedSearch) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `saved_search` WHERE `id` = ?", delete.ID)
+	return err
+}
+
+func vacuumSavedSearch(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `saved_search` WHERE `creator_id` NOT IN (SELECT `id` FROM `user`)"
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
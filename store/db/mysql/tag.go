@@ -5,12 +5,43 @@ import (
 	"database/sql"
 	"strings"
 
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) UpsertTag(ctx context.Context, upsert *store.Tag) (*store.Tag, error) {
-	stmt := "INSERT INTO `tag` (`name`, `creator_id`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `name` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.Name, upsert.CreatorID, upsert.Name); err != nil {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if upsert.Payload == nil {
+		stmt := "INSERT INTO `tag` (`name`, `creator_id`, `payload`) VALUES (?, ?, '{}') ON DUPLICATE KEY UPDATE `name` = `name`"
+		if _, err := tx.ExecContext(ctx, stmt, upsert.Name, upsert.CreatorID); err != nil {
+			return nil, err
+		}
+	} else {
+		payloadBytes, err := protojson.Marshal(upsert.Payload)
+		if err != nil {
+			return nil, err
+		}
+		stmt := "INSERT INTO `tag` (`name`, `creator_id`, `payload`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `payload` = ?"
+		if _, err := tx.ExecContext(ctx, stmt, upsert.Name, upsert.CreatorID, string(payloadBytes), string(payloadBytes)); err != nil {
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM `tag_alias` WHERE `creator_id` = ? AND `tag` = ?", upsert.CreatorID, upsert.Name); err != nil {
+			return nil, err
+		}
+		for _, alias := range upsert.Aliases {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO `tag_alias` (`creator_id`, `alias`, `tag`) VALUES (?, ?, ?)", upsert.CreatorID, alias, upsert.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -23,8 +54,11 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 	if find.CreatorID != 0 {
 		where, args = append(where, "`creator_id` = ?"), append(args, find.CreatorID)
 	}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
 
-	query := "SELECT `name`, `creator_id` FROM `tag` WHERE " + strings.Join(where, " AND ") + " ORDER BY name ASC"
+	query := "SELECT `name`, `creator_id`, `payload` FROM `tag` WHERE " + strings.Join(where, " AND ") + " ORDER BY name ASC"
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -33,14 +67,23 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 
 	list := []*store.Tag{}
 	for rows.Next() {
-		tag := &store.Tag{}
+		tag := &store.Tag{
+			Aliases: []string{},
+		}
+		var payloadBytes []byte
 		if err := rows.Scan(
 			&tag.Name,
 			&tag.CreatorID,
+			&payloadBytes,
 		); err != nil {
 			return nil, err
 		}
 
+		payload := &storepb.TagPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		tag.Payload = payload
 		list = append(list, tag)
 	}
 
@@ -48,20 +91,83 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 		return nil, err
 	}
 
+	if err := d.listTagAliases(ctx, list, find); err != nil {
+		return nil, err
+	}
 	return list, nil
 }
 
+// listTagAliases sets the aliases of the listed tags.
+func (d *DB) listTagAliases(ctx context.Context, tags []*store.Tag, find *store.FindTag) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.CreatorID != 0 {
+		where, args = append(where, "`creator_id` = ?"), append(args, find.CreatorID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "`tag` = ?"), append(args, *find.Name)
+	}
+
+	query := "SELECT `creator_id`, `tag`, `alias` FROM `tag_alias` WHERE " + strings.Join(where, " AND ") + " ORDER BY `alias` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type tagKey struct {
+		creatorID int32
+		name      string
+	}
+	tagMap := make(map[tagKey]*store.Tag)
+	for _, tag := range tags {
+		tagMap[tagKey{creatorID: tag.CreatorID, name: tag.Name}] = tag
+	}
+	for rows.Next() {
+		key, alias := tagKey{}, ""
+		if err := rows.Scan(&key.creatorID, &key.name, &alias); err != nil {
+			return err
+		}
+		if tag, ok := tagMap[key]; ok {
+			tag.Aliases = append(tag.Aliases, alias)
+		}
+	}
+	return rows.Err()
+}
+
 func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
-	where, args := []string{"`name` = ?", "`creator_id` = ?"}, []any{delete.Name, delete.CreatorID}
-	stmt := "DELETE FROM `tag` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	if _, err = result.RowsAffected(); err != nil {
+	defer tx.Rollback()
+
+	if err := renameTag(ctx, tx, delete.CreatorID, &store.TagRename{From: delete.Name}); err != nil {
 		return err
 	}
-	return nil
+	return tx.Commit()
+}
+
+// renameTag moves the tag with its metadata to the new name, or deletes it if the new name is empty.
+// The metadata of an existing tag with the new name is kept, while the aliases are merged.
+func renameTag(ctx context.Context, tx *sql.Tx, creatorID int32, rename *store.TagRename) error {
+	if rename.To == "" {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM `tag_alias` WHERE `creator_id` = ? AND `tag` = ?", creatorID, rename.From); err != nil {
+			return err
+		}
+	} else {
+		stmt := "INSERT INTO `tag` (`name`, `creator_id`, `payload`) SELECT ?, `creator_id`, `payload` FROM `tag` AS `source` WHERE `source`.`name` = ? AND `source`.`creator_id` = ? ON DUPLICATE KEY UPDATE `tag`.`name` = `tag`.`name`"
+		if _, err := tx.ExecContext(ctx, stmt, rename.To, rename.From, creatorID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE `tag_alias` SET `tag` = ? WHERE `creator_id` = ? AND `tag` = ?", rename.To, creatorID, rename.From); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM `tag_alias` WHERE `creator_id` = ? AND `alias` = ? AND `tag` = ?", creatorID, rename.To, rename.To); err != nil {
+			return err
+		}
+	}
+	_, err := tx.ExecContext(ctx, "DELETE FROM `tag` WHERE `name` = ? AND `creator_id` = ?", rename.From, creatorID)
+	return err
 }
 
 func vacuumTag(ctx context.Context, tx *sql.Tx) error {
@@ -73,3 +179,13 @@ func vacuumTag(ctx context.Context, tx *sql.Tx) error {
 
 	return nil
 }
+
+func vacuumTagAlias(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `tag_alias` WHERE NOT EXISTS (SELECT 1 FROM `tag` WHERE `tag`.`name` = `tag_alias`.`tag` AND `tag`.`creator_id` = `tag_alias`.`creator_id`)"
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
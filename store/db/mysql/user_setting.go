@@ -27,6 +27,8 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting)
 		valueString = upsert.GetAppearance()
 	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_MEMO_VISIBILITY {
 		valueString = upsert.GetMemoVisibility()
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID {
+		valueString = upsert.GetTelegramUserId()
 	} else {
 		return nil, errors.Errorf("unknown user setting key: %s", upsert.Key.String())
 	}
@@ -47,6 +49,9 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 	if v := find.UserID; v != nil {
 		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
 	}
+	if v := find.TelegramUserID; v != nil {
+		where, args = append(where, "`key` = ? AND `value` = ?"), append(args, storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID.String(), *v)
+	}
 
 	query := "SELECT `user_id`, `key`, `value` FROM `user_setting` WHERE " + strings.Join(where, " AND ")
 	rows, err := d.db.QueryContext(ctx, query, args...)
@@ -87,6 +92,10 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 			userSetting.Value = &storepb.UserSetting_MemoVisibility{
 				MemoVisibility: valueString,
 			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID {
+			userSetting.Value = &storepb.UserSetting_TelegramUserId{
+				TelegramUserId: valueString,
+			}
 		} else {
 			// Skip unknown user setting key.
 			continue
@@ -4,14 +4,26 @@ import (
 	"context"
 	"strings"
 
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*storepb.Webhook, error) {
-	fields := []string{"`name`", "`url`", "`creator_id`"}
-	placeholder := []string{"?", "?", "?"}
-	args := []any{create.Name, create.Url, create.CreatorId}
+	filterString := "{}"
+	if create.Filter != nil {
+		bytes, err := protojson.Marshal(create.Filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal webhook filter")
+		}
+		filterString = string(bytes)
+	}
+
+	fields := []string{"`name`", "`url`", "`creator_id`", "`filter`", "`secret`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.Name, create.Url, create.CreatorId, filterString, create.Secret}
 
 	stmt := "INSERT INTO `webhook` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -24,8 +36,8 @@ func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*store
 		return nil, err
 	}
 
-	create.Id = int32(id)
-	return create, nil
+	id32 := int32(id)
+	return d.GetWebhook(ctx, &store.FindWebhook{ID: &id32})
 }
 
 func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*storepb.Webhook, error) {
@@ -37,7 +49,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
 	}
 
-	rows, err := d.db.QueryContext(ctx, "SELECT `id`, `created_ts`, `updated_ts`, `row_status`, `creator_id`, `name`, `url` FROM `webhook` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` DESC",
+	rows, err := d.db.QueryContext(ctx, "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `row_status`, `creator_id`, `name`, `url`, `filter`, `secret` FROM `webhook` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` DESC",
 		args...,
 	)
 	if err != nil {
@@ -49,6 +61,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 	for rows.Next() {
 		webhook := &storepb.Webhook{}
 		var rowStatus string
+		var filterBytes []byte
 		if err := rows.Scan(
 			&webhook.Id,
 			&webhook.CreatedTs,
@@ -57,10 +70,17 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			&webhook.CreatorId,
 			&webhook.Name,
 			&webhook.Url,
+			&filterBytes,
+			&webhook.Secret,
 		); err != nil {
 			return nil, err
 		}
 		webhook.RowStatus = storepb.RowStatus(storepb.RowStatus_value[rowStatus])
+		filter := &storepb.WebhookFilter{}
+		if err := protojsonUnmarshaler.Unmarshal(filterBytes, filter); err != nil {
+			return nil, err
+		}
+		webhook.Filter = filter
 		list = append(list, webhook)
 	}
 
@@ -93,6 +113,16 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 	if update.URL != nil {
 		set, args = append(set, "`url` = ?"), append(args, *update.URL)
 	}
+	if update.Filter != nil {
+		bytes, err := protojson.Marshal(update.Filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal webhook filter")
+		}
+		set, args = append(set, "`filter` = ?"), append(args, string(bytes))
+	}
+	if update.Secret != nil {
+		set, args = append(set, "`secret` = ?"), append(args, *update.Secret)
+	}
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `webhook` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
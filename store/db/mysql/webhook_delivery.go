@@ -0,0 +1,141 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	fields := []string{"`webhook_id`", "`activity_type`", "`payload`", "`attempt`", "`status`", "`next_attempt_ts`", "`response_body`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "FROM_UNIXTIME(?)", "?"}
+	args := []any{create.WebhookID, create.ActivityType, create.Payload, create.Attempt, create.Status, create.NextAttemptTs, create.ResponseBody}
+
+	stmt := "INSERT INTO `webhook_delivery` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create webhook delivery")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.WebhookID; v != nil {
+		where, args = append(where, "`webhook_id` = ?"), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, "`status` = ?"), append(args, *v)
+	}
+	if v := find.NextAttemptTsBefore; v != nil {
+		where, args = append(where, "UNIX_TIMESTAMP(`next_attempt_ts`) <= ?"), append(args, *v)
+	}
+
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `webhook_id`, `activity_type`, `payload`, `attempt`, `status`, UNIX_TIMESTAMP(`next_attempt_ts`), `status_code`, `latency_ms`, `response_body` FROM `webhook_delivery` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		webhookDelivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&webhookDelivery.ID,
+			&webhookDelivery.CreatedTs,
+			&webhookDelivery.UpdatedTs,
+			&webhookDelivery.WebhookID,
+			&webhookDelivery.ActivityType,
+			&webhookDelivery.Payload,
+			&webhookDelivery.Attempt,
+			&webhookDelivery.Status,
+			&webhookDelivery.NextAttemptTs,
+			&webhookDelivery.StatusCode,
+			&webhookDelivery.LatencyMs,
+			&webhookDelivery.ResponseBody,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, webhookDelivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) (*store.WebhookDelivery, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := update.Status; v != nil {
+		set, args = append(set, "`status` = ?"), append(args, *v)
+	}
+	if v := update.StatusCode; v != nil {
+		set, args = append(set, "`status_code` = ?"), append(args, *v)
+	}
+	if v := update.LatencyMs; v != nil {
+		set, args = append(set, "`latency_ms` = ?"), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, "`response_body` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `webhook_delivery` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("webhook delivery %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func vacuumWebhookDelivery(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `webhook_delivery` WHERE `webhook_id` NOT IN (SELECT `id` FROM `webhook`)"
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
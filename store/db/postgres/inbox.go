@@ -59,6 +59,13 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 	if find.Status != nil {
 		qb = qb.Where(squirrel.Eq{"status": *find.Status})
 	}
+	qb = qb.OrderBy("created_ts DESC", "id DESC")
+	if find.Limit != nil {
+		qb = qb.Limit(uint64(*find.Limit))
+		if find.Offset != nil {
+			qb = qb.Offset(uint64(*find.Offset))
+		}
+	}
 
 	query, args, err := qb.ToSql()
 	if err != nil {
@@ -51,6 +51,21 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 }
 
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+	if find.SearchQuery != nil {
+		return nil, errors.Wrap(store.ErrMemoSearchNotSupported, "postgres")
+	}
+	if find.Filter != nil {
+		// The filters are not compiled to SQL here, so only the simple ones lowered to the other fields are supported.
+		lowered, matchable, err := store.LowerMemoFilter(find)
+		if err != nil {
+			return nil, err
+		}
+		if !matchable {
+			return []*store.Memo{}, nil
+		}
+		find = lowered
+	}
+
 	// Start building the SELECT statement
 	builder := squirrel.Select(
 		"memo.id AS id",
@@ -91,6 +106,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			builder = builder.Where("memo.content LIKE ?", "%"+s+"%")
 		}
 	}
+	if v := find.Tag; v != nil {
+		builder = builder.Where("EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND (memo_tag.tag = ? OR memo_tag.tag IN (SELECT tag_alias.tag FROM tag_alias WHERE tag_alias.creator_id = memo.creator_id AND tag_alias.alias = ?)))", *v, *v)
+	}
 
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholders := make([]string, len(v))
@@ -49,6 +49,12 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.Type != nil {
 		qb = qb.Where(squirrel.Eq{"type": *find.Type})
 	}
+	if len(find.TypeList) > 0 {
+		qb = qb.Where(squirrel.Eq{"type": find.TypeList})
+	}
+	if find.CreatorID != nil {
+		qb = qb.Where("memo_id IN (SELECT id FROM memo WHERE creator_id = ?)", *find.CreatorID)
+	}
 
 	query, args, err := qb.ToSql()
 	if err != nil {
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoRelationTypeDefinition(ctx context.Context, create *store.MemoRelationTypeDefinition) (*store.MemoRelationTypeDefinition, error) {
+	directed := 0
+	if create.Directed {
+		directed = 1
+	}
+	query, args, err := squirrel.Insert("memo_relation_type").
+		Columns("creator_id", "name", "inverse_name", "directed").
+		Values(create.CreatorID, create.Name, create.InverseName, directed).
+		Suffix("RETURNING id, created_ts").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(&create.ID, &create.CreatedTs); err != nil {
+		return nil, err
+	}
+
+	definition := create
+	return definition, nil
+}
+
+func (d *DB) ListMemoRelationTypeDefinitions(ctx context.Context, find *store.FindMemoRelationTypeDefinition) ([]*store.MemoRelationTypeDefinition, error) {
+	qb := squirrel.Select("id", "created_ts", "creator_id", "name", "inverse_name", "directed").From("memo_relation_type").OrderBy("name ASC")
+
+	if find.ID != nil {
+		qb = qb.Where(squirrel.Eq{"id": *find.ID})
+	}
+	if find.CreatorID != nil {
+		qb = qb.Where(squirrel.Eq{"creator_id": *find.CreatorID})
+	}
+	if find.Name != nil {
+		qb = qb.Where(squirrel.Eq{"name": *find.Name})
+	}
+
+	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoRelationTypeDefinition{}
+	for rows.Next() {
+		definition := &store.MemoRelationTypeDefinition{}
+		if err := rows.Scan(
+			&definition.ID,
+			&definition.CreatedTs,
+			&definition.CreatorID,
+			&definition.Name,
+			&definition.InverseName,
+			&definition.Directed,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, definition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateMemoRelationTypeDefinition(ctx context.Context, update *store.UpdateMemoRelationTypeDefinition) (*store.MemoRelationTypeDefinition, error) {
+	qb := squirrel.Update("memo_relation_type").Where(squirrel.Eq{"id": update.ID})
+	if update.InverseName != nil {
+		qb = qb.Set("inverse_name", *update.InverseName)
+	}
+
+	query, args, err := qb.Suffix("RETURNING id, created_ts, creator_id, name, inverse_name, directed").PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	definition := &store.MemoRelationTypeDefinition{}
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+		&definition.ID,
+		&definition.CreatedTs,
+		&definition.CreatorID,
+		&definition.Name,
+		&definition.InverseName,
+		&definition.Directed,
+	); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+func (d *DB) DeleteMemoRelationTypeDefinition(ctx context.Context, delete *store.DeleteMemoRelationTypeDefinition) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The relations of a custom type are only between the memos of its creator.
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM memo_relation
+		USING memo, memo_relation_type
+		WHERE memo_relation_type.id = $1 AND memo_relation.type = memo_relation_type.name
+			AND memo.id = memo_relation.memo_id AND memo.creator_id = memo_relation_type.creator_id`, delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_relation_type WHERE id = $1", delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func vacuumMemoRelationType(ctx context.Context, tx *sql.Tx) error {
+	subQuery, subArgs, err := squirrel.Select("id").From(`"user"`).PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	query, args, err := squirrel.Delete("memo_relation_type").
+		Where(fmt.Sprintf("creator_id NOT IN (%s)", subQuery), subArgs...).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoRevision(ctx context.Context, create *store.MemoRevision) (*store.MemoRevision, error) {
+	qb := squirrel.Insert("memo_revision").
+		Columns("memo_id", "creator_id", "content").
+		Values(create.MemoID, create.CreatorID, create.Content).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	stmt, args, err := qb.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var id int32
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&id); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListMemoRevisions(ctx, &store.FindMemoRevision{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create memo revision")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoRevisions(ctx context.Context, find *store.FindMemoRevision) ([]*store.MemoRevision, error) {
+	qb := squirrel.Select("id", "memo_id", "creator_id", "created_ts", "content").
+		From("memo_revision").
+		Where("1 = 1").
+		OrderBy("created_ts DESC", "id DESC").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if v := find.ID; v != nil {
+		qb = qb.Where(squirrel.Eq{"id": *v})
+	}
+	if v := find.MemoID; v != nil {
+		qb = qb.Where(squirrel.Eq{"memo_id": *v})
+	}
+	if v := find.Limit; v != nil {
+		qb = qb.Limit(uint64(*v))
+		if v := find.Offset; v != nil {
+			qb = qb.Offset(uint64(*v))
+		}
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoRevision{}
+	for rows.Next() {
+		memoRevision := &store.MemoRevision{}
+		if err := rows.Scan(&memoRevision.ID, &memoRevision.MemoID, &memoRevision.CreatorID, &memoRevision.CreatedTs, &memoRevision.Content); err != nil {
+			return nil, err
+		}
+		list = append(list, memoRevision)
+	}
+
+	return list, rows.Err()
+}
+
+func (d *DB) DeleteMemoRevision(ctx context.Context, delete *store.DeleteMemoRevision) error {
+	qb := squirrel.Delete("memo_revision").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if v := delete.ID; v != nil {
+		qb = qb.Where(squirrel.Eq{"id": *v})
+	}
+	if v := delete.MemoID; v != nil {
+		qb = qb.Where(squirrel.Eq{"memo_id": *v})
+	}
+
+	stmt, args, err := qb.ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	_, err = result.RowsAffected()
+	return err
+}
+
+func vacuumMemoRevision(ctx context.Context, tx *sql.Tx) error {
+	subQuery, subArgs, err := squirrel.Select("id").From("memo").PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	query, args, err := squirrel.Delete("memo_revision").
+		Where(fmt.Sprintf("memo_id NOT IN (%s)", subQuery), subArgs...).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoTags(ctx context.Context, upsert *store.UpsertMemoTags) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := replaceMemoTags(ctx, tx, upsert.MemoID, upsert.Tags); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ApplyTagRewrite keeps the previous contents as revisions, so the memos' updated_ts is left unchanged.
+func (d *DB) ApplyTagRewrite(ctx context.Context, apply *store.ApplyTagRewrite) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, memo := range apply.Memos {
+		// The memo is only rewritten if it is unchanged since its content was read.
+		result, err := tx.ExecContext(ctx, "UPDATE memo SET content = $1 WHERE id = $2 AND content = $3", memo.Content, memo.MemoID, memo.PreviousContent)
+		if err != nil {
+			return err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return errors.Wrapf(store.ErrTagRewriteConflict, "memo %d", memo.MemoID)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO memo_revision (memo_id, creator_id, content) VALUES ($1, $2, $3)", memo.MemoID, memo.CreatorID, memo.PreviousContent); err != nil {
+			return err
+		}
+		if err := replaceMemoTags(ctx, tx, memo.MemoID, memo.Tags); err != nil {
+			return err
+		}
+	}
+	for _, rename := range apply.TagRenames {
+		if err := renameTag(ctx, tx, apply.CreatorID, rename); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func replaceMemoTags(ctx context.Context, tx *sql.Tx, memoID int32, memoTags []*store.MemoTag) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_tag WHERE memo_id = $1", memoID); err != nil {
+		return err
+	}
+	if len(memoTags) == 0 {
+		return nil
+	}
+	qb := squirrel.Insert("memo_tag").Columns("memo_id", "tag", "implicit")
+	for _, memoTag := range memoTags {
+		implicit := 0
+		if memoTag.Implicit {
+			implicit = 1
+		}
+		qb = qb.Values(memoID, memoTag.Tag, implicit)
+	}
+	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (d *DB) ListMemoTagCounts(ctx context.Context, find *store.FindMemoTag) ([]*store.MemoTagCount, error) {
+	qb := squirrel.Select("memo_tag.tag", "COUNT(*)").
+		From("memo_tag").
+		Join("memo ON memo.id = memo_tag.memo_id").
+		GroupBy("memo_tag.tag").
+		OrderBy("memo_tag.tag ASC")
+	if v := find.CreatorID; v != nil {
+		qb = qb.Where(squirrel.Eq{"memo.creator_id": *v})
+	}
+	if v := find.RowStatus; v != nil {
+		qb = qb.Where(squirrel.Eq{"memo.row_status": *v})
+	}
+	if find.ExcludeImplicit {
+		qb = qb.Where(squirrel.Eq{"memo_tag.implicit": 0})
+	}
+
+	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTagCount{}
+	for rows.Next() {
+		memoTagCount := &store.MemoTagCount{}
+		if err := rows.Scan(
+			&memoTagCount.Tag,
+			&memoTagCount.MemoCount,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTagCount)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) ListMemoTags(ctx context.Context, find *store.FindMemoTag) ([]*store.MemoTag, error) {
+	qb := squirrel.Select("memo_tag.memo_id", "memo_tag.tag", "memo_tag.implicit", "memo.created_ts").
+		From("memo_tag").
+		Join("memo ON memo.id = memo_tag.memo_id").
+		OrderBy("memo_tag.memo_id ASC", "memo_tag.tag ASC")
+	if v := find.CreatorID; v != nil {
+		qb = qb.Where(squirrel.Eq{"memo.creator_id": *v})
+	}
+	if v := find.RowStatus; v != nil {
+		qb = qb.Where(squirrel.Eq{"memo.row_status": *v})
+	}
+	if find.ExcludeImplicit {
+		qb = qb.Where(squirrel.Eq{"memo_tag.implicit": 0})
+	}
+
+	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTag{}
+	for rows.Next() {
+		memoTag := &store.MemoTag{}
+		if err := rows.Scan(
+			&memoTag.MemoID,
+			&memoTag.Tag,
+			&memoTag.Implicit,
+			&memoTag.MemoCreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func vacuumMemoTag(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM memo_tag WHERE memo_id NOT IN (SELECT id FROM memo)`
+	_, err := tx.ExecContext(ctx, stmt)
+	return err
+}
@@ -13,6 +13,12 @@ DROP TABLE IF EXISTS storage CASCADE;
 DROP TABLE IF EXISTS idp CASCADE;
 DROP TABLE IF EXISTS inbox CASCADE;
 DROP TABLE IF EXISTS webhook CASCADE;
+DROP TABLE IF EXISTS memo_revision CASCADE;
+DROP TABLE IF EXISTS webhook_delivery CASCADE;
+DROP TABLE IF EXISTS saved_search CASCADE;
+DROP TABLE IF EXISTS memo_tag CASCADE;
+DROP TABLE IF EXISTS tag_alias CASCADE;
+DROP TABLE IF EXISTS memo_relation_type CASCADE;
 
 -- migration_history
 CREATE TABLE migration_history (
@@ -49,6 +55,8 @@ CREATE TABLE user_setting (
   UNIQUE(user_id, key)
 );
 
+CREATE INDEX idx_user_setting_telegram_user_id ON user_setting (value) WHERE key = 'USER_SETTING_TELEGRAM_USER_ID';
+
 -- memo
 CREATE TABLE memo (
   id SERIAL PRIMARY KEY,
@@ -95,6 +103,7 @@ CREATE TABLE resource (
 CREATE TABLE tag (
   name TEXT NOT NULL,
   creator_id INTEGER NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
   UNIQUE(name, creator_id)
 );
 
@@ -143,5 +152,80 @@ CREATE TABLE webhook (
   row_status TEXT NOT NULL DEFAULT 'NORMAL',
   creator_id INTEGER NOT NULL,
   name TEXT NOT NULL,
-  url TEXT NOT NULL
+  url TEXT NOT NULL,
+  filter TEXT NOT NULL DEFAULT '{}',
+  secret TEXT NOT NULL DEFAULT ''
+);
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  webhook_id INTEGER NOT NULL,
+  activity_type TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  attempt INTEGER NOT NULL DEFAULT 1,
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  next_attempt_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  status_code INTEGER NOT NULL DEFAULT 0,
+  latency_ms BIGINT NOT NULL DEFAULT 0,
+  response_body TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery (webhook_id);
+
+CREATE INDEX idx_webhook_delivery_status ON webhook_delivery (status, next_attempt_ts);
+
+-- saved_search
+CREATE TABLE saved_search (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  filter TEXT NOT NULL,
+  order_by TEXT NOT NULL DEFAULT 'ORDER_UNSPECIFIED',
+  pinned_position INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(creator_id, name)
+);
+
+-- memo_tag
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  implicit INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag);
+
+-- tag_alias
+CREATE TABLE tag_alias (
+  creator_id INTEGER NOT NULL,
+  alias TEXT NOT NULL,
+  tag TEXT NOT NULL,
+  UNIQUE(creator_id, alias)
+);
+
+-- memo_relation_type
+CREATE TABLE memo_relation_type (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  inverse_name TEXT NOT NULL DEFAULT '',
+  directed INTEGER NOT NULL DEFAULT 1,
+  UNIQUE(creator_id, name)
 );
@@ -66,7 +66,25 @@ func (d *DB) Vacuum(ctx context.Context) error {
 	if err := vacuumMemoRelations(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumMemoRevision(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumWebhookDelivery(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumTag(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumSavedSearch(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoTag(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumTagAlias(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoRelationType(ctx, tx); err != nil {
 		// Prevent revive warning.
 		return err
 	}
@@ -78,6 +96,10 @@ func (*DB) BackupTo(context.Context, string) error {
 	return errors.New("Please use postgresdump to backup")
 }
 
+func (*DB) RestoreFrom(context.Context, string) error {
+	return errors.New("Please use psql to restore")
+}
+
 func (*DB) GetCurrentDBSize(context.Context) (int64, error) {
 	return 0, errors.New("unimplemented")
 }
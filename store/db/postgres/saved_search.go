@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateSavedSearch(ctx context.Context, create *storepb.SavedSearch) (*storepb.SavedSearch, error) {
+	qb := squirrel.Insert("saved_search").Columns("creator_id", "name", "filter", "order_by", "pinned_position")
+	values := []any{create.CreatorId, create.Name, create.Filter, create.Order.String(), create.PinnedPosition}
+
+	qb = qb.Values(values...).Suffix("RETURNING id")
+	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.db.QueryRowContext(ctx, query, args...).Scan(&create.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	create, err = d.GetSavedSearch(ctx, &store.FindSavedSearch{ID: &create.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListSavedSearches(ctx context.Context, find *store.FindSavedSearch) ([]*storepb.SavedSearch, error) {
+	qb := squirrel.Select("id", "created_ts", "updated_ts", "creator_id", "name", "filter", "order_by", "pinned_position").From("saved_search").OrderBy("pinned_position = 0", "pinned_position ASC", "name ASC")
+
+	if find.ID != nil {
+		qb = qb.Where(squirrel.Eq{"id": *find.ID})
+	}
+	if find.CreatorID != nil {
+		qb = qb.Where(squirrel.Eq{"creator_id": *find.CreatorID})
+	}
+	if find.Name != nil {
+		qb = qb.Where(squirrel.Eq{"name": *find.Name})
+	}
+
+	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*storepb.SavedSearch{}
+	for rows.Next() {
+		savedSearch := &storepb.SavedSearch{}
+		var order string
+		if err := rows.Scan(
+			&savedSearch.Id,
+			&savedSearch.CreatedTs,
+			&savedSearch.UpdatedTs,
+			&savedSearch.CreatorId,
+			&savedSearch.Name,
+			&savedSearch.Filter,
+			&order,
+			&savedSearch.PinnedPosition,
+		); err != nil {
+			return nil, err
+		}
+		savedSearch.Order = storepb.SavedSearch_Order(storepb.SavedSearch_Order_value[order])
+
+		list = append(list, savedSearch)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) GetSavedSearch(ctx context.Context, find *store.FindSavedSearch) (*storepb.SavedSearch, error) {
+	list, err := d.ListSavedSearches(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (d *DB) UpdateSavedSearch(ctx context.Context, update *store.UpdateSavedSearch) (*storepb.SavedSearch, error) {
+	qb := squirrel.Update("saved_search")
+
+	if update.UpdatedTs != nil {
+		qb = qb.Set("updated_ts", *update.UpdatedTs)
+	}
+	if update.Name != nil {
+		qb = qb.Set("name", *update.Name)
+	}
+	if update.Filter != nil {
+		qb = qb.Set("filter", *update.Filter)
+	}
+	if update.Order != nil {
+		qb = qb.Set("order_by", update.Order.String())
+	}
+	if update.PinnedPosition != nil {
+		qb = qb.Set("pinned_position", *update.PinnedPosition)
+	}
+
+	qb = qb.Where(squirrel.Eq{"id": update.ID})
+
+	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = d.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	savedSearch, err := d.GetSavedSearch(ctx, &store.FindSavedSearch{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return savedSearch, nil
+}
+
+func (d *DB) DeleteSavedSearch(ctx context.Context, delete *store.DeleteSavedSearch) error {
+	qb := squirrel.Delete("saved_search").Where(squirrel.Eq{"id": delete.ID})
+
+	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = d.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func vacuumSavedSearch(ctx context.Context, tx *sql.Tx) error {
+	subQuery, subArgs, err := squirrel.Select("id").From(`"user"`).PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	query, args, err := squirrel.Delete("saved_search").
+		Where(fmt.Sprintf("creator_id NOT IN (%s)", subQuery), subArgs...).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
@@ -6,20 +6,50 @@ import (
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) UpsertTag(ctx context.Context, upsert *store.Tag) (*store.Tag, error) {
-	stmt := "INSERT INTO tag (name, creator_id) VALUES ($1, $2) ON CONFLICT (name, creator_id) DO UPDATE SET name = $3"
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.Name, upsert.CreatorID, upsert.Name); err != nil {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if upsert.Payload == nil {
+		stmt := "INSERT INTO tag (name, creator_id) VALUES ($1, $2) ON CONFLICT (name, creator_id) DO NOTHING"
+		if _, err := tx.ExecContext(ctx, stmt, upsert.Name, upsert.CreatorID); err != nil {
+			return nil, err
+		}
+	} else {
+		payloadBytes, err := protojson.Marshal(upsert.Payload)
+		if err != nil {
+			return nil, err
+		}
+		stmt := "INSERT INTO tag (name, creator_id, payload) VALUES ($1, $2, $3) ON CONFLICT (name, creator_id) DO UPDATE SET payload = EXCLUDED.payload"
+		if _, err := tx.ExecContext(ctx, stmt, upsert.Name, upsert.CreatorID, string(payloadBytes)); err != nil {
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM tag_alias WHERE creator_id = $1 AND tag = $2", upsert.CreatorID, upsert.Name); err != nil {
+			return nil, err
+		}
+		for _, alias := range upsert.Aliases {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO tag_alias (creator_id, alias, tag) VALUES ($1, $2, $3)", upsert.CreatorID, alias, upsert.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 	return upsert, nil
 }
 
 func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, error) {
-	builder := squirrel.Select("name", "creator_id").From("tag").
+	builder := squirrel.Select("name", "creator_id", "payload").From("tag").
 		Where("1 = 1").
 		OrderBy("name ASC").
 		PlaceholderFormat(squirrel.Dollar)
@@ -27,6 +57,9 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 	if find.CreatorID != 0 {
 		builder = builder.Where("creator_id = ?", find.CreatorID)
 	}
+	if find.Name != nil {
+		builder = builder.Where("name = ?", *find.Name)
+	}
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -41,14 +74,23 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 
 	list := []*store.Tag{}
 	for rows.Next() {
-		tag := &store.Tag{}
+		tag := &store.Tag{
+			Aliases: []string{},
+		}
+		var payloadBytes []byte
 		if err := rows.Scan(
 			&tag.Name,
 			&tag.CreatorID,
+			&payloadBytes,
 		); err != nil {
 			return nil, err
 		}
 
+		payload := &storepb.TagPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		tag.Payload = payload
 		list = append(list, tag)
 	}
 
@@ -56,29 +98,88 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 		return nil, err
 	}
 
+	if err := d.listTagAliases(ctx, list, find); err != nil {
+		return nil, err
+	}
 	return list, nil
 }
 
-func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
-	builder := squirrel.Delete("tag").
-		Where(squirrel.Eq{"name": delete.Name, "creator_id": delete.CreatorID}).
+// listTagAliases sets the aliases of the listed tags.
+func (d *DB) listTagAliases(ctx context.Context, tags []*store.Tag, find *store.FindTag) error {
+	builder := squirrel.Select("creator_id", "tag", "alias").From("tag_alias").
+		OrderBy("alias ASC").
 		PlaceholderFormat(squirrel.Dollar)
+	if find.CreatorID != 0 {
+		builder = builder.Where("creator_id = ?", find.CreatorID)
+	}
+	if find.Name != nil {
+		builder = builder.Where("tag = ?", *find.Name)
+	}
 
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return err
 	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type tagKey struct {
+		creatorID int32
+		name      string
+	}
+	tagMap := make(map[tagKey]*store.Tag)
+	for _, tag := range tags {
+		tagMap[tagKey{creatorID: tag.CreatorID, name: tag.Name}] = tag
+	}
+	for rows.Next() {
+		key, alias := tagKey{}, ""
+		if err := rows.Scan(&key.creatorID, &key.name, &alias); err != nil {
+			return err
+		}
+		if tag, ok := tagMap[key]; ok {
+			tag.Aliases = append(tag.Aliases, alias)
+		}
+	}
+	return rows.Err()
+}
 
-	result, err := d.db.ExecContext(ctx, query, args...)
+func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	if _, err = result.RowsAffected(); err != nil {
+	if err := renameTag(ctx, tx, delete.CreatorID, &store.TagRename{From: delete.Name}); err != nil {
 		return err
 	}
+	return tx.Commit()
+}
 
-	return nil
+// renameTag moves the tag with its metadata to the new name, or deletes it if the new name is empty.
+// The metadata of an existing tag with the new name is kept, while the aliases are merged.
+func renameTag(ctx context.Context, tx *sql.Tx, creatorID int32, rename *store.TagRename) error {
+	if rename.To == "" {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM tag_alias WHERE creator_id = $1 AND tag = $2", creatorID, rename.From); err != nil {
+			return err
+		}
+	} else {
+		stmt := "INSERT INTO tag (name, creator_id, payload) SELECT $1, creator_id, payload FROM tag WHERE name = $2 AND creator_id = $3 ON CONFLICT (name, creator_id) DO NOTHING"
+		if _, err := tx.ExecContext(ctx, stmt, rename.To, rename.From, creatorID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE tag_alias SET tag = $1 WHERE creator_id = $2 AND tag = $3", rename.To, creatorID, rename.From); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM tag_alias WHERE creator_id = $1 AND alias = $2 AND tag = $2", creatorID, rename.To); err != nil {
+			return err
+		}
+	}
+	_, err := tx.ExecContext(ctx, "DELETE FROM tag WHERE name = $1 AND creator_id = $2", rename.From, creatorID)
+	return err
 }
 
 func vacuumTag(ctx context.Context, tx *sql.Tx) error {
@@ -101,3 +202,9 @@ func vacuumTag(ctx context.Context, tx *sql.Tx) error {
 	_, err = tx.ExecContext(ctx, query, args...)
 	return err
 }
+
+func vacuumTagAlias(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM tag_alias WHERE NOT EXISTS (SELECT 1 FROM tag WHERE tag.name = tag_alias.tag AND tag.creator_id = tag_alias.creator_id)`
+	_, err := tx.ExecContext(ctx, stmt)
+	return err
+}
@@ -27,6 +27,8 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting)
 		valueString = upsert.GetAppearance()
 	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_MEMO_VISIBILITY {
 		valueString = upsert.GetMemoVisibility()
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID {
+		valueString = upsert.GetTelegramUserId()
 	} else {
 		return nil, errors.Errorf("unknown user setting key: %s", upsert.Key.String())
 	}
@@ -62,6 +64,10 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 	if v := find.UserID; v != nil {
 		qb = qb.Where(squirrel.Eq{"user_id": *v})
 	}
+	if v := find.TelegramUserID; v != nil {
+		// The key is inlined to match the partial index on the telegram user ids.
+		qb = qb.Where("key = 'USER_SETTING_TELEGRAM_USER_ID'").Where(squirrel.Eq{"value": *v})
+	}
 
 	// Finalize the query
 	query, args, err := qb.ToSql()
@@ -109,6 +115,10 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 			userSetting.Value = &storepb.UserSetting_MemoVisibility{
 				MemoVisibility: valueString,
 			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID {
+			userSetting.Value = &storepb.UserSetting_TelegramUserId{
+				TelegramUserId: valueString,
+			}
 		} else {
 			// Skip unknown user setting key.
 			continue
@@ -4,14 +4,25 @@ import (
 	"context"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*storepb.Webhook, error) {
-	qb := squirrel.Insert("webhook").Columns("name", "url", "creator_id")
-	values := []any{create.Name, create.Url, create.CreatorId}
+	filterString := "{}"
+	if create.Filter != nil {
+		bytes, err := protojson.Marshal(create.Filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal webhook filter")
+		}
+		filterString = string(bytes)
+	}
+
+	qb := squirrel.Insert("webhook").Columns("name", "url", "creator_id", "filter", "secret")
+	values := []any{create.Name, create.Url, create.CreatorId, filterString, create.Secret}
 
 	qb = qb.Values(values...).Suffix("RETURNING id")
 	query, args, err := qb.PlaceholderFormat(squirrel.Dollar).ToSql()
@@ -33,7 +44,7 @@ func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*store
 }
 
 func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*storepb.Webhook, error) {
-	qb := squirrel.Select("id", "created_ts", "updated_ts", "row_status", "creator_id", "name", "url").From("webhook").OrderBy("id DESC")
+	qb := squirrel.Select("id", "created_ts", "updated_ts", "row_status", "creator_id", "name", "url", "filter", "secret").From("webhook").OrderBy("id DESC")
 
 	if find.ID != nil {
 		qb = qb.Where(squirrel.Eq{"id": *find.ID})
@@ -57,6 +68,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 	for rows.Next() {
 		webhook := &storepb.Webhook{}
 		var rowStatus string
+		var filterBytes []byte
 		if err := rows.Scan(
 			&webhook.Id,
 			&webhook.CreatedTs,
@@ -65,11 +77,18 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			&webhook.CreatorId,
 			&webhook.Name,
 			&webhook.Url,
+			&filterBytes,
+			&webhook.Secret,
 		); err != nil {
 			return nil, err
 		}
 
 		webhook.RowStatus = storepb.RowStatus(storepb.RowStatus_value[rowStatus])
+		filter := &storepb.WebhookFilter{}
+		if err := protojsonUnmarshaler.Unmarshal(filterBytes, filter); err != nil {
+			return nil, err
+		}
+		webhook.Filter = filter
 
 		list = append(list, webhook)
 	}
@@ -104,6 +123,16 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 	if update.URL != nil {
 		qb = qb.Set("url", *update.URL)
 	}
+	if update.Filter != nil {
+		bytes, err := protojson.Marshal(update.Filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal webhook filter")
+		}
+		qb = qb.Set("filter", string(bytes))
+	}
+	if update.Secret != nil {
+		qb = qb.Set("secret", *update.Secret)
+	}
 
 	qb = qb.Where(squirrel.Eq{"id": update.ID})
 
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	qb := squirrel.Insert("webhook_delivery").
+		Columns("webhook_id", "activity_type", "payload", "attempt", "status", "next_attempt_ts").
+		Values(create.WebhookID, create.ActivityType, create.Payload, create.Attempt, create.Status, create.NextAttemptTs).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	stmt, args, err := qb.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var id int32
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&id); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create webhook delivery")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	qb := squirrel.Select("id", "created_ts", "updated_ts", "webhook_id", "activity_type", "payload", "attempt", "status", "next_attempt_ts", "status_code", "latency_ms", "response_body").
+		From("webhook_delivery").
+		Where("1 = 1").
+		OrderBy("id DESC").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if v := find.ID; v != nil {
+		qb = qb.Where(squirrel.Eq{"id": *v})
+	}
+	if v := find.WebhookID; v != nil {
+		qb = qb.Where(squirrel.Eq{"webhook_id": *v})
+	}
+	if v := find.Status; v != nil {
+		qb = qb.Where(squirrel.Eq{"status": *v})
+	}
+	if v := find.NextAttemptTsBefore; v != nil {
+		qb = qb.Where(squirrel.LtOrEq{"next_attempt_ts": *v})
+	}
+	if v := find.Limit; v != nil {
+		qb = qb.Limit(uint64(*v))
+		if v := find.Offset; v != nil {
+			qb = qb.Offset(uint64(*v))
+		}
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		webhookDelivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&webhookDelivery.ID,
+			&webhookDelivery.CreatedTs,
+			&webhookDelivery.UpdatedTs,
+			&webhookDelivery.WebhookID,
+			&webhookDelivery.ActivityType,
+			&webhookDelivery.Payload,
+			&webhookDelivery.Attempt,
+			&webhookDelivery.Status,
+			&webhookDelivery.NextAttemptTs,
+			&webhookDelivery.StatusCode,
+			&webhookDelivery.LatencyMs,
+			&webhookDelivery.ResponseBody,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, webhookDelivery)
+	}
+
+	return list, rows.Err()
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) (*store.WebhookDelivery, error) {
+	qb := squirrel.Update("webhook_delivery").
+		Where(squirrel.Eq{"id": update.ID}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if v := update.UpdatedTs; v != nil {
+		qb = qb.Set("updated_ts", *v)
+	}
+	if v := update.Status; v != nil {
+		qb = qb.Set("status", *v)
+	}
+	if v := update.StatusCode; v != nil {
+		qb = qb.Set("status_code", *v)
+	}
+	if v := update.LatencyMs; v != nil {
+		qb = qb.Set("latency_ms", *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		qb = qb.Set("response_body", *v)
+	}
+
+	stmt, args, err := qb.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("webhook delivery %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func vacuumWebhookDelivery(ctx context.Context, tx *sql.Tx) error {
+	subQuery, subArgs, err := squirrel.Select("id").From("webhook").PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	query, args, err := squirrel.Delete("webhook_delivery").
+		Where(fmt.Sprintf("webhook_id NOT IN (%s)", subQuery), subArgs...).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
@@ -28,7 +28,35 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 }
 
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
-	where, args := []string{"1 = 1"}, []any{}
+	with, joins, where, args := "", []string{}, []string{"1 = 1"}, []any{}
+
+	if v := find.SearchQuery; v != nil {
+		query := convertSearchQueryToFTS(*v)
+		if query == "" {
+			return []*store.Memo{}, nil
+		}
+		if condition, likeArgs, ok := convertSearchQueryToLike(*v); ok {
+			// The short terms are searched by scanning the content, without ranks and snippets.
+			with = `WITH search AS MATERIALIZED (
+			SELECT id AS rowid, 0 AS rank, '' AS snippet
+			FROM memo
+			WHERE ` + condition + `
+		)
+		`
+			args = append(args, likeArgs...)
+		} else {
+			// Auxiliary FTS5 functions can only be used in the full-text query itself,
+			// so the matched memos are materialized before being joined and grouped.
+			with = `WITH search AS MATERIALIZED (
+			SELECT rowid, bm25(memo_fts) AS rank, snippet(memo_fts, 0, '<mark>', '</mark>', '...', 16) AS snippet
+			FROM memo_fts
+			WHERE memo_fts MATCH ?
+		)
+		`
+			args = append(args, query)
+		}
+		joins = append(joins, "JOIN search ON memo.id = search.rowid")
+	}
 
 	if v := find.ID; v != nil {
 		where, args = append(where, "memo.id = ?"), append(args, *v)
@@ -50,6 +78,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where, args = append(where, "memo.content LIKE ?"), append(args, "%"+s+"%")
 		}
 	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, memoTagCondition), append(args, *v, *v)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
@@ -61,15 +92,27 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.Pinned; v != nil {
 		where = append(where, "memo_organizer.pinned = 1")
 	}
+	if v := find.Filter; v != nil {
+		condition, filterArgs, err := convertMemoFilterToSQL(v)
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, filterArgs...)
+	}
 
 	orders := []string{}
-	if find.OrderByPinned {
-		orders = append(orders, "pinned DESC")
-	}
-	if find.OrderByUpdatedTs {
-		orders = append(orders, "updated_ts DESC")
+	if find.SearchQuery != nil {
+		// The more relevant the memo is, the lower its bm25 rank is.
+		orders = append(orders, "search.rank ASC")
 	} else {
-		orders = append(orders, "created_ts DESC")
+		if find.OrderByPinned {
+			orders = append(orders, "pinned DESC")
+		}
+		if find.OrderByUpdatedTs {
+			orders = append(orders, "updated_ts DESC")
+		} else {
+			orders = append(orders, "created_ts DESC")
+		}
 	}
 	orders = append(orders, "id DESC")
 
@@ -85,10 +128,14 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if !find.ExcludeContent {
 		fields = append(fields, `memo.content AS content`)
 	}
+	if find.SearchQuery != nil {
+		fields = append(fields, `search.snippet AS snippet`)
+	}
 
-	query := `SELECT ` + strings.Join(fields, ", ") + `
+	query := with + `SELECT ` + strings.Join(fields, ", ") + `
 		FROM memo
 		LEFT JOIN memo_organizer ON memo.id = memo_organizer.memo_id
+		` + strings.Join(joins, "\n") + `
 		WHERE ` + strings.Join(where, " AND ") + `
 		GROUP BY memo.id
 		ORDER BY ` + strings.Join(orders, ", ")
@@ -120,6 +167,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		if !find.ExcludeContent {
 			dests = append(dests, &memo.Content)
 		}
+		if find.SearchQuery != nil {
+			dests = append(dests, &memo.Snippet)
+		}
 		if err := rows.Scan(dests...); err != nil {
 			return nil, err
 		}
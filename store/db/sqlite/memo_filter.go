@@ -0,0 +1,182 @@
+package sqlite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// taskListPatterns are the LIKE patterns of the task list items, either done or not.
+var taskListPatterns = []string{"%- [ ] %", "%- [x] %", "%* [ ] %", "%* [x] %"}
+
+// convertMemoFilterToSQL converts the memo filter to a condition of the memo list query.
+// Every condition is parenthesized and never NULL, so they can be combined and negated safely.
+func convertMemoFilterToSQL(filter *store.MemoFilter) (string, []any, error) {
+	switch filter.Kind {
+	case store.MemoFilterAnd, store.MemoFilterOr:
+		if len(filter.Children) == 0 {
+			return "", nil, errors.Errorf("%s filter without operands", filter.Kind)
+		}
+		conditions, args := []string{}, []any{}
+		for _, child := range filter.Children {
+			condition, childArgs, err := convertMemoFilterToSQL(child)
+			if err != nil {
+				return "", nil, err
+			}
+			conditions, args = append(conditions, condition), append(args, childArgs...)
+		}
+		return "(" + strings.Join(conditions, fmt.Sprintf(" %s ", filter.Kind)) + ")", args, nil
+	case store.MemoFilterNot:
+		if len(filter.Children) != 1 {
+			return "", nil, errors.Errorf("NOT filter with %d operands", len(filter.Children))
+		}
+		condition, args, err := convertMemoFilterToSQL(filter.Children[0])
+		if err != nil {
+			return "", nil, err
+		}
+		return "(NOT " + condition + ")", args, nil
+	case store.MemoFilterCondition:
+		return convertMemoFilterConditionToSQL(filter)
+	default:
+		return "", nil, errors.Errorf("unknown filter kind %q", filter.Kind)
+	}
+}
+
+func convertMemoFilterConditionToSQL(filter *store.MemoFilter) (string, []any, error) {
+	switch filter.Field {
+	case store.MemoFilterVisibility:
+		return compareMemoFilterColumn("memo.visibility", filter, false)
+	case store.MemoFilterRowStatus:
+		return compareMemoFilterColumn("memo.row_status", filter, false)
+	case store.MemoFilterCreatedTs:
+		return compareMemoFilterColumn("memo.created_ts", filter, true)
+	case store.MemoFilterUpdatedTs:
+		return compareMemoFilterColumn("memo.updated_ts", filter, true)
+	case store.MemoFilterTag:
+		return matchMemoFilterValues(filter, func(value any) (string, []any, error) {
+			tag, ok := value.(string)
+			if !ok {
+				return "", nil, errors.Errorf("invalid tag %v", value)
+			}
+			// A tag also matches its sub tags, e.g. "work" matches "#work/project", by the implicit parent tags in the index.
+			return "(" + memoTagCondition + ")", []any{tag, tag}, nil
+		})
+	case store.MemoFilterContentSearch:
+		return matchMemoFilterValues(filter, func(value any) (string, []any, error) {
+			text, ok := value.(string)
+			if !ok {
+				return "", nil, errors.Errorf("invalid content search %v", value)
+			}
+			return `(memo.content LIKE ? ESCAPE '\')`, []any{"%" + escapeLikePattern(text) + "%"}, nil
+		})
+	case store.MemoFilterPinned:
+		return matchMemoFilterBool(filter, "(EXISTS (SELECT 1 FROM memo_organizer AS organizer WHERE organizer.memo_id = memo.id AND organizer.pinned = 1))")
+	case store.MemoFilterHasResource:
+		return matchMemoFilterBool(filter, "(EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id))")
+	case store.MemoFilterHasTask:
+		conditions := []string{}
+		for _, pattern := range taskListPatterns {
+			conditions = append(conditions, fmt.Sprintf("memo.content LIKE '%s'", pattern))
+		}
+		return matchMemoFilterBool(filter, "("+strings.Join(conditions, " OR ")+")")
+	case store.MemoFilterRelatedTo:
+		return matchMemoFilterValues(filter, func(value any) (string, []any, error) {
+			memoID, ok := value.(int64)
+			if !ok {
+				return "", nil, errors.Errorf("invalid memo id %v", value)
+			}
+			return `(EXISTS (SELECT 1 FROM memo_relation WHERE (memo_relation.memo_id = memo.id AND memo_relation.related_memo_id = ?) OR (memo_relation.related_memo_id = memo.id AND memo_relation.memo_id = ?)))`, []any{memoID, memoID}, nil
+		})
+	default:
+		return "", nil, errors.Errorf("unknown filter field %q", filter.Field)
+	}
+}
+
+// compareMemoFilterColumn compares the column to the value, where only the ordered columns support the order operators.
+func compareMemoFilterColumn(column string, filter *store.MemoFilter, ordered bool) (string, []any, error) {
+	switch filter.Operator {
+	case store.MemoFilterEqual:
+		return fmt.Sprintf("(%s = ?)", column), []any{filter.Value}, nil
+	case store.MemoFilterNotEqual:
+		return fmt.Sprintf("(%s != ?)", column), []any{filter.Value}, nil
+	case store.MemoFilterLess, store.MemoFilterLessOrEqual, store.MemoFilterGreater, store.MemoFilterGreaterOrEqual:
+		if !ordered {
+			return "", nil, errors.Errorf("operator %s is not supported by %s", filter.Operator, filter.Field)
+		}
+		return fmt.Sprintf("(%s %s ?)", column, filter.Operator), []any{filter.Value}, nil
+	case store.MemoFilterIn:
+		values, ok := filter.Value.([]any)
+		if !ok {
+			return "", nil, errors.Errorf("operator in requires a list for %s", filter.Field)
+		}
+		if len(values) == 0 {
+			return "(0)", nil, nil
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+		return fmt.Sprintf("(%s IN (%s))", column, placeholders), values, nil
+	default:
+		return "", nil, errors.Errorf("operator %s is not supported by %s", filter.Operator, filter.Field)
+	}
+}
+
+// matchMemoFilterValues builds the condition of a field matched by a predicate on each value,
+// i.e. == matches the value, != does not match it and in matches any of the values.
+func matchMemoFilterValues(filter *store.MemoFilter, match func(value any) (string, []any, error)) (string, []any, error) {
+	switch filter.Operator {
+	case store.MemoFilterEqual, store.MemoFilterNotEqual:
+		condition, args, err := match(filter.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		if filter.Operator == store.MemoFilterNotEqual {
+			condition = "(NOT " + condition + ")"
+		}
+		return condition, args, nil
+	case store.MemoFilterIn:
+		values, ok := filter.Value.([]any)
+		if !ok {
+			return "", nil, errors.Errorf("operator in requires a list for %s", filter.Field)
+		}
+		if len(values) == 0 {
+			return "(0)", nil, nil
+		}
+		conditions, args := []string{}, []any{}
+		for _, value := range values {
+			condition, valueArgs, err := match(value)
+			if err != nil {
+				return "", nil, err
+			}
+			conditions, args = append(conditions, condition), append(args, valueArgs...)
+		}
+		return "(" + strings.Join(conditions, " OR ") + ")", args, nil
+	default:
+		return "", nil, errors.Errorf("operator %s is not supported by %s", filter.Operator, filter.Field)
+	}
+}
+
+// matchMemoFilterBool builds the condition of a boolean field, which is true when the condition holds.
+func matchMemoFilterBool(filter *store.MemoFilter, condition string) (string, []any, error) {
+	value, ok := filter.Value.(bool)
+	if !ok {
+		return "", nil, errors.Errorf("invalid value %v for %s", filter.Value, filter.Field)
+	}
+	switch filter.Operator {
+	case store.MemoFilterEqual:
+	case store.MemoFilterNotEqual:
+		value = !value
+	default:
+		return "", nil, errors.Errorf("operator %s is not supported by %s", filter.Operator, filter.Field)
+	}
+	if !value {
+		condition = "(NOT " + condition + ")"
+	}
+	return condition, nil, nil
+}
+
+// escapeLikePattern escapes the wildcards of LIKE in s, for the patterns with ESCAPE '\'.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
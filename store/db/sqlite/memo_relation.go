@@ -47,6 +47,16 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.Type != nil {
 		where, args = append(where, "type = ?"), append(args, find.Type)
 	}
+	if len(find.TypeList) > 0 {
+		placeholders := []string{}
+		for _, relationType := range find.TypeList {
+			placeholders, args = append(placeholders, "?"), append(args, relationType)
+		}
+		where = append(where, "type IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "memo_id IN (SELECT id FROM memo WHERE creator_id = ?)"), append(args, *find.CreatorID)
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
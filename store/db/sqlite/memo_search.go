@@ -0,0 +1,184 @@
+package sqlite
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// minSearchTermLength is the length of the trigrams indexed by memo_fts.
+// The shorter terms, e.g. the two-character Chinese words, never match a full-text query.
+const minSearchTermLength = 3
+
+type searchToken struct {
+	value      string
+	isOperator bool
+	// term is the unquoted search term of the operands.
+	term string
+}
+
+// convertSearchQueryToFTS converts a user search query to a FTS5 match expression.
+// Terms and phrases are quoted so that punctuation in them can not break the FTS5 syntax,
+// while prefix stars, parentheses and the AND, OR and NOT operators are kept.
+// Operators and parentheses in invalid positions are dropped instead of failing the query.
+func convertSearchQueryToFTS(query string) string {
+	values := []string{}
+	for _, token := range normalizeSearchTokens(tokenizeSearchQuery(query)) {
+		values = append(values, token.value)
+	}
+	return strings.Join(values, " ")
+}
+
+// convertSearchQueryToLike converts a user search query to a condition on the memo content with LIKE,
+// if any of its terms is too short for the trigram index. The operators and parentheses are kept,
+// and the binary NOT of FTS5 becomes AND NOT, which has the same precedence over AND and OR.
+func convertSearchQueryToLike(query string) (string, []any, bool) {
+	tokens := normalizeSearchTokens(tokenizeSearchQuery(query))
+	short := false
+	for _, token := range tokens {
+		if !token.isOperator && utf8.RuneCountInString(token.term) < minSearchTermLength {
+			short = true
+		}
+	}
+	if !short {
+		return "", nil, false
+	}
+
+	conditions, args := []string{}, []any{}
+	for _, token := range tokens {
+		switch {
+		case !token.isOperator:
+			conditions, args = append(conditions, `content LIKE ? ESCAPE '\'`), append(args, "%"+escapeLikePattern(token.term)+"%")
+		case token.value == "NOT":
+			conditions = append(conditions, "AND NOT")
+		default:
+			conditions = append(conditions, token.value)
+		}
+	}
+	return strings.Join(conditions, " "), args, true
+}
+
+func tokenizeSearchQuery(query string) []searchToken {
+	tokens := []searchToken{}
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, searchToken{value: string(r), isOperator: true})
+			i++
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				j++
+			}
+			phrase := strings.TrimSpace(string(runes[i+1 : j]))
+			i = j + 1
+			if i < len(runes) && runes[i] == '*' {
+				phrase += "*"
+				i++
+			}
+			if token, ok := newSearchTermToken(phrase); ok {
+				tokens = append(tokens, token)
+			}
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && runes[j] != '"' && runes[j] != '(' && runes[j] != ')' {
+				j++
+			}
+			word := string(runes[i:j])
+			i = j
+			if word == "AND" || word == "OR" || word == "NOT" {
+				tokens = append(tokens, searchToken{value: word, isOperator: true})
+			} else if token, ok := newSearchTermToken(word); ok {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	return tokens
+}
+
+func newSearchTermToken(term string) (searchToken, bool) {
+	prefix := strings.HasSuffix(term, "*")
+	term = strings.TrimRight(term, "*")
+	// The trigrams keep the punctuation, which would make "dog:" miss "dog" at the end of a sentence.
+	term = strings.TrimFunc(term, unicode.IsPunct)
+	if term == "" {
+		return searchToken{}, false
+	}
+	value := `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+	if prefix {
+		value += "*"
+	}
+	return searchToken{value: value, term: term}, true
+}
+
+func isBinarySearchOperator(token searchToken) bool {
+	return token.isOperator && (token.value == "AND" || token.value == "OR" || token.value == "NOT")
+}
+
+// normalizeSearchTokens drops the operators which have no operand on either side,
+// balances the parentheses and joins the adjacent operands with AND.
+func normalizeSearchTokens(tokens []searchToken) []searchToken {
+	list := []searchToken{}
+	depth := 0
+	for _, token := range tokens {
+		switch {
+		case token.value == "(" && token.isOperator:
+			depth++
+			list = append(list, token)
+		case token.value == ")" && token.isOperator:
+			if depth == 0 {
+				continue
+			}
+			depth--
+			list = closeSearchGroup(list)
+		case isBinarySearchOperator(token):
+			if len(list) == 0 {
+				continue
+			}
+			if last := list[len(list)-1]; isBinarySearchOperator(last) || (last.isOperator && last.value == "(") {
+				continue
+			}
+			list = append(list, token)
+		default:
+			list = append(list, token)
+		}
+	}
+	for ; depth > 0; depth-- {
+		list = closeSearchGroup(list)
+	}
+	list = trimSearchOperators(list)
+
+	// FTS5 only allows implicit AND between phrases, so it is made explicit around parentheses.
+	normalized := []searchToken{}
+	for i, token := range list {
+		if i > 0 {
+			last := list[i-1]
+			if (!last.isOperator || last.value == ")") && (!token.isOperator || token.value == "(") {
+				normalized = append(normalized, searchToken{value: "AND", isOperator: true})
+			}
+		}
+		normalized = append(normalized, token)
+	}
+	return normalized
+}
+
+// closeSearchGroup closes the innermost open parenthesis, dropping the group if it is empty.
+func closeSearchGroup(list []searchToken) []searchToken {
+	list = trimSearchOperators(list)
+	if last := list[len(list)-1]; last.isOperator && last.value == "(" {
+		return list[:len(list)-1]
+	}
+	return append(list, searchToken{value: ")", isOperator: true})
+}
+
+// trimSearchOperators drops the trailing operators which have no right operand.
+func trimSearchOperators(list []searchToken) []searchToken {
+	for len(list) > 0 && isBinarySearchOperator(list[len(list)-1]) {
+		list = list[:len(list)-1]
+	}
+	return list
+}
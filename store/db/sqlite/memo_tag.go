@@ -0,0 +1,178 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// memoTagCondition matches the memos with the tag, its sub tags or the tag of the alias, taking the tag twice as args.
+const memoTagCondition = "EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND (memo_tag.tag = ? OR memo_tag.tag IN (SELECT tag_alias.tag FROM tag_alias WHERE tag_alias.creator_id = memo.creator_id AND tag_alias.alias = ?)))"
+
+func (d *DB) UpsertMemoTags(ctx context.Context, upsert *store.UpsertMemoTags) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := replaceMemoTags(ctx, tx, upsert.MemoID, upsert.Tags); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ApplyTagRewrite keeps the previous contents as revisions, so the memos' updated_ts is left unchanged.
+func (d *DB) ApplyTagRewrite(ctx context.Context, apply *store.ApplyTagRewrite) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, memo := range apply.Memos {
+		// The memo is only rewritten if it is unchanged since its content was read.
+		result, err := tx.ExecContext(ctx, "UPDATE memo SET content = ? WHERE id = ? AND content = ?", memo.Content, memo.MemoID, memo.PreviousContent)
+		if err != nil {
+			return err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return errors.Wrapf(store.ErrTagRewriteConflict, "memo %d", memo.MemoID)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO memo_revision (memo_id, creator_id, content) VALUES (?, ?, ?)", memo.MemoID, memo.CreatorID, memo.PreviousContent); err != nil {
+			return err
+		}
+		if err := replaceMemoTags(ctx, tx, memo.MemoID, memo.Tags); err != nil {
+			return err
+		}
+	}
+	for _, rename := range apply.TagRenames {
+		if err := renameTag(ctx, tx, apply.CreatorID, rename); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func replaceMemoTags(ctx context.Context, tx *sql.Tx, memoID int32, memoTags []*store.MemoTag) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_tag WHERE memo_id = ?", memoID); err != nil {
+		return err
+	}
+	for _, memoTag := range memoTags {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO memo_tag (memo_id, tag, implicit) VALUES (?, ?, ?)", memoID, memoTag.Tag, memoTag.Implicit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DB) ListMemoTagCounts(ctx context.Context, find *store.FindMemoTag) ([]*store.MemoTagCount, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "memo.creator_id = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "memo.row_status = ?"), append(args, *v)
+	}
+	if find.ExcludeImplicit {
+		where = append(where, "memo_tag.implicit = 0")
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			memo_tag.tag,
+			COUNT(*)
+		FROM memo_tag
+		INNER JOIN memo ON memo.id = memo_tag.memo_id
+		WHERE `+strings.Join(where, " AND ")+`
+		GROUP BY memo_tag.tag
+		ORDER BY memo_tag.tag ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTagCount{}
+	for rows.Next() {
+		memoTagCount := &store.MemoTagCount{}
+		if err := rows.Scan(
+			&memoTagCount.Tag,
+			&memoTagCount.MemoCount,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTagCount)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) ListMemoTags(ctx context.Context, find *store.FindMemoTag) ([]*store.MemoTag, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "memo.creator_id = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "memo.row_status = ?"), append(args, *v)
+	}
+	if find.ExcludeImplicit {
+		where = append(where, "memo_tag.implicit = 0")
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			memo_tag.memo_id,
+			memo_tag.tag,
+			memo_tag.implicit,
+			memo.created_ts
+		FROM memo_tag
+		INNER JOIN memo ON memo.id = memo_tag.memo_id
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY memo_tag.memo_id ASC, memo_tag.tag ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTag{}
+	for rows.Next() {
+		memoTag := &store.MemoTag{}
+		if err := rows.Scan(
+			&memoTag.MemoID,
+			&memoTag.Tag,
+			&memoTag.Implicit,
+			&memoTag.MemoCreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func vacuumMemoTag(ctx context.Context, tx *sql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM memo_tag
+		WHERE memo_id NOT IN (SELECT id FROM memo)
+	`); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1 @@
+ALTER TABLE webhook ADD COLUMN filter TEXT NOT NULL DEFAULT '{}';
@@ -0,0 +1,12 @@
+-- saved_search
+CREATE TABLE saved_search (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  filter TEXT NOT NULL,
+  order_by TEXT NOT NULL DEFAULT 'ORDER_UNSPECIFIED',
+  pinned_position INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(creator_id, name)
+);
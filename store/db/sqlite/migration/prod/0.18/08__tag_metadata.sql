@@ -0,0 +1,9 @@
+ALTER TABLE tag ADD COLUMN payload TEXT NOT NULL DEFAULT '{}';
+
+-- tag_alias
+CREATE TABLE tag_alias (
+  creator_id INTEGER NOT NULL,
+  alias TEXT NOT NULL,
+  tag TEXT NOT NULL,
+  UNIQUE(creator_id, alias)
+);
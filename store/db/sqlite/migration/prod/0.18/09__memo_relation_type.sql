@@ -0,0 +1,10 @@
+-- memo_relation_type
+CREATE TABLE memo_relation_type (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  inverse_name TEXT NOT NULL DEFAULT '',
+  directed INTEGER NOT NULL CHECK (directed IN (0, 1)) DEFAULT 1,
+  UNIQUE(creator_id, name)
+);
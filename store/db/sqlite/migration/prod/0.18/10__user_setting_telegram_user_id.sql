@@ -0,0 +1,2 @@
+-- user_setting
+CREATE INDEX idx_user_setting_telegram_user_id ON user_setting (value) WHERE key = 'USER_SETTING_TELEGRAM_USER_ID';
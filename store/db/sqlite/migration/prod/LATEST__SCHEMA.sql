@@ -13,6 +13,9 @@ DROP TABLE IF EXISTS storage;
 DROP TABLE IF EXISTS idp;
 DROP TABLE IF EXISTS inbox;
 DROP TABLE IF EXISTS webhook;
+DROP TABLE IF EXISTS memo_revision;
+DROP TABLE IF EXISTS memo_fts;
+DROP TABLE IF EXISTS webhook_delivery;
 
 -- migration_history
 CREATE TABLE migration_history (
@@ -52,6 +55,8 @@ CREATE TABLE user_setting (
   UNIQUE(user_id, key)
 );
 
+CREATE INDEX idx_user_setting_telegram_user_id ON user_setting (value) WHERE key = 'USER_SETTING_TELEGRAM_USER_ID';
+
 -- memo
 CREATE TABLE memo (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -106,6 +111,7 @@ CREATE INDEX idx_resource_memo_id ON resource (memo_id);
 CREATE TABLE tag (
   name TEXT NOT NULL,
   creator_id INTEGER NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
   UNIQUE(name, creator_id)
 );
 
@@ -154,7 +160,103 @@ CREATE TABLE webhook (
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
   creator_id INTEGER NOT NULL,
   name TEXT NOT NULL,
-  url TEXT NOT NULL
+  url TEXT NOT NULL,
+  filter TEXT NOT NULL DEFAULT '{}',
+  secret TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_webhook_creator_id ON webhook (creator_id);
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
+
+-- memo_fts
+CREATE VIRTUAL TABLE memo_fts USING fts5(
+  content,
+  content = 'memo',
+  content_rowid = 'id',
+  tokenize = 'trigram'
+);
+
+CREATE TRIGGER memo_fts_after_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_after_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_after_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  webhook_id INTEGER NOT NULL,
+  activity_type TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  attempt INTEGER NOT NULL DEFAULT 1,
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')) DEFAULT 'PENDING',
+  next_attempt_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status_code INTEGER NOT NULL DEFAULT 0,
+  latency_ms INTEGER NOT NULL DEFAULT 0,
+  response_body TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery (webhook_id);
+
+CREATE INDEX idx_webhook_delivery_status ON webhook_delivery (status, next_attempt_ts);
+
+-- saved_search
+CREATE TABLE saved_search (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  filter TEXT NOT NULL,
+  order_by TEXT NOT NULL DEFAULT 'ORDER_UNSPECIFIED',
+  pinned_position INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(creator_id, name)
+);
+
+-- memo_tag
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  implicit INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag);
+
+-- tag_alias
+CREATE TABLE tag_alias (
+  creator_id INTEGER NOT NULL,
+  alias TEXT NOT NULL,
+  tag TEXT NOT NULL,
+  UNIQUE(creator_id, alias)
+);
+
+-- memo_relation_type
+CREATE TABLE memo_relation_type (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  inverse_name TEXT NOT NULL DEFAULT '',
+  directed INTEGER NOT NULL CHECK (directed IN (0, 1)) DEFAULT 1,
+  UNIQUE(creator_id, name)
+);
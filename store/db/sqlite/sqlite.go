@@ -95,7 +95,25 @@ func vacuumImpl(ctx context.Context, tx *sql.Tx) error {
 	if err := vacuumMemoRelations(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumMemoRevision(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumWebhookDelivery(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumTag(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumSavedSearch(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoTag(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumTagAlias(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoRelationType(ctx, tx); err != nil {
 		// Prevent revive warning.
 		return err
 	}
@@ -140,6 +158,45 @@ func (d *DB) BackupTo(ctx context.Context, filename string) error {
 	return nil
 }
 
+// RestoreFrom replaces the content of the database with the given database file.
+// The pages are copied in a single step, so the other connections see either the old or the new content.
+func (d *DB) RestoreFrom(ctx context.Context, filename string) error {
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "fail to open new connection")
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn any) error {
+		type restorer interface {
+			NewRestore(string) (*sqlite.Backup, error)
+		}
+		restoreConn, ok := driverConn.(restorer)
+		if !ok {
+			return errors.New("db connection is not a sqlite restorer")
+		}
+
+		rst, err := restoreConn.NewRestore(filename)
+		if err != nil {
+			return errors.Wrap(err, "fail to create sqlite restore")
+		}
+
+		for more := true; more; {
+			more, err = rst.Step(-1)
+			if err != nil {
+				return errors.Wrap(err, "fail to execute sqlite restore")
+			}
+		}
+
+		return rst.Finish()
+	})
+	if err != nil {
+		return errors.Wrap(err, "fail to restore")
+	}
+
+	return nil
+}
+
 func (d *DB) GetCurrentDBSize(context.Context) (int64, error) {
 	fi, err := os.Stat(d.profile.DSN)
 	if err != nil {
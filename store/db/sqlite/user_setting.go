@@ -82,6 +82,8 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting)
 		is_bool = true
 	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_SYS_SHORTCUT_CONFIG {
 		valueString = upsert.GetSysShortcutConfig()
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID {
+		valueString = upsert.GetTelegramUserId()
 	} else {
 		return nil, errors.Errorf("unknown user setting key: %s", upsert.Key.String())
 	}
@@ -113,6 +115,10 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 	if v := find.UserID; v != nil {
 		where, args = append(where, "user_id = ?"), append(args, *find.UserID)
 	}
+	if v := find.TelegramUserID; v != nil {
+		// The key is inlined to match the partial index on the telegram user ids.
+		where, args = append(where, "key = 'USER_SETTING_TELEGRAM_USER_ID' AND value = ?"), append(args, *v)
+	}
 
 	query := `
 		SELECT
@@ -223,6 +229,10 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 			userSetting.Value = &storepb.UserSetting_SysShortcutConfig{
 				SysShortcutConfig: valueString,
 			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID {
+			userSetting.Value = &storepb.UserSetting_TelegramUserId{
+				TelegramUserId: valueString,
+			}
 		} else {
 			// Skip unknown user setting key.
 			continue
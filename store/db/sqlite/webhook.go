@@ -4,14 +4,25 @@ import (
 	"context"
 	"strings"
 
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*storepb.Webhook, error) {
-	fields := []string{"`name`", "`url`", "`creator_id`"}
-	placeholder := []string{"?", "?", "?"}
-	args := []any{create.Name, create.Url, create.CreatorId}
+	if create.Filter == nil {
+		create.Filter = &storepb.WebhookFilter{}
+	}
+	bytes, err := protojson.Marshal(create.Filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal webhook filter")
+	}
+
+	fields := []string{"`name`", "`url`", "`creator_id`", "`filter`", "`secret`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.Name, create.Url, create.CreatorId, string(bytes), create.Secret}
 	stmt := "INSERT INTO `webhook` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
 	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -45,7 +56,9 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			row_status,
 			creator_id,
 			name,
-			url
+			url,
+			filter,
+			secret
 		FROM webhook
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY id DESC`,
@@ -60,6 +73,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 	for rows.Next() {
 		webhook := &storepb.Webhook{}
 		var rowStatus string
+		var filterBytes []byte
 		if err := rows.Scan(
 			&webhook.Id,
 			&webhook.CreatedTs,
@@ -68,10 +82,17 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			&webhook.CreatorId,
 			&webhook.Name,
 			&webhook.Url,
+			&filterBytes,
+			&webhook.Secret,
 		); err != nil {
 			return nil, err
 		}
 		webhook.RowStatus = storepb.RowStatus(storepb.RowStatus_value[rowStatus])
+		filter := &storepb.WebhookFilter{}
+		if err := protojsonUnmarshaler.Unmarshal(filterBytes, filter); err != nil {
+			return nil, err
+		}
+		webhook.Filter = filter
 		list = append(list, webhook)
 	}
 
@@ -93,11 +114,22 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 	if update.URL != nil {
 		set, args = append(set, "url = ?"), append(args, *update.URL)
 	}
+	if update.Filter != nil {
+		bytes, err := protojson.Marshal(update.Filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal webhook filter")
+		}
+		set, args = append(set, "filter = ?"), append(args, string(bytes))
+	}
+	if update.Secret != nil {
+		set, args = append(set, "secret = ?"), append(args, *update.Secret)
+	}
 	args = append(args, update.ID)
 
-	stmt := "UPDATE `webhook` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `created_ts`, `updated_ts`, `row_status`, `creator_id`, `name`, `url`"
+	stmt := "UPDATE `webhook` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `created_ts`, `updated_ts`, `row_status`, `creator_id`, `name`, `url`, `filter`, `secret`"
 	webhook := &storepb.Webhook{}
 	var rowStatus string
+	var filterBytes []byte
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&webhook.Id,
 		&webhook.CreatedTs,
@@ -106,10 +138,17 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 		&webhook.CreatorId,
 		&webhook.Name,
 		&webhook.Url,
+		&filterBytes,
+		&webhook.Secret,
 	); err != nil {
 		return nil, err
 	}
 	webhook.RowStatus = storepb.RowStatus(storepb.RowStatus_value[rowStatus])
+	filter := &storepb.WebhookFilter{}
+	if err := protojsonUnmarshaler.Unmarshal(filterBytes, filter); err != nil {
+		return nil, err
+	}
+	webhook.Filter = filter
 	return webhook, nil
 }
 
@@ -0,0 +1,149 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	fields := []string{"`webhook_id`", "`activity_type`", "`payload`", "`attempt`", "`status`", "`next_attempt_ts`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.WebhookID, create.ActivityType, create.Payload, create.Attempt, create.Status, create.NextAttemptTs}
+
+	stmt := "INSERT INTO `webhook_delivery` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `status_code`, `latency_ms`, `response_body`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+		&create.StatusCode,
+		&create.LatencyMs,
+		&create.ResponseBody,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.WebhookID; v != nil {
+		where, args = append(where, "`webhook_id` = ?"), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, "`status` = ?"), append(args, *v)
+	}
+	if v := find.NextAttemptTsBefore; v != nil {
+		where, args = append(where, "`next_attempt_ts` <= ?"), append(args, *v)
+	}
+
+	query := "SELECT `id`, `created_ts`, `updated_ts`, `webhook_id`, `activity_type`, `payload`, `attempt`, `status`, `next_attempt_ts`, `status_code`, `latency_ms`, `response_body` FROM `webhook_delivery` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		webhookDelivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&webhookDelivery.ID,
+			&webhookDelivery.CreatedTs,
+			&webhookDelivery.UpdatedTs,
+			&webhookDelivery.WebhookID,
+			&webhookDelivery.ActivityType,
+			&webhookDelivery.Payload,
+			&webhookDelivery.Attempt,
+			&webhookDelivery.Status,
+			&webhookDelivery.NextAttemptTs,
+			&webhookDelivery.StatusCode,
+			&webhookDelivery.LatencyMs,
+			&webhookDelivery.ResponseBody,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, webhookDelivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) (*store.WebhookDelivery, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Status; v != nil {
+		set, args = append(set, "`status` = ?"), append(args, *v)
+	}
+	if v := update.StatusCode; v != nil {
+		set, args = append(set, "`status_code` = ?"), append(args, *v)
+	}
+	if v := update.LatencyMs; v != nil {
+		set, args = append(set, "`latency_ms` = ?"), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, "`response_body` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `webhook_delivery` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `created_ts`, `updated_ts`, `webhook_id`, `activity_type`, `payload`, `attempt`, `status`, `next_attempt_ts`, `status_code`, `latency_ms`, `response_body`"
+	webhookDelivery := &store.WebhookDelivery{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&webhookDelivery.ID,
+		&webhookDelivery.CreatedTs,
+		&webhookDelivery.UpdatedTs,
+		&webhookDelivery.WebhookID,
+		&webhookDelivery.ActivityType,
+		&webhookDelivery.Payload,
+		&webhookDelivery.Attempt,
+		&webhookDelivery.Status,
+		&webhookDelivery.NextAttemptTs,
+		&webhookDelivery.StatusCode,
+		&webhookDelivery.LatencyMs,
+		&webhookDelivery.ResponseBody,
+	); err != nil {
+		return nil, err
+	}
+
+	return webhookDelivery, nil
+}
+
+func vacuumWebhookDelivery(ctx context.Context, tx *sql.Tx) error {
+	stmt := `
+	DELETE FROM
+		webhook_delivery
+	WHERE
+		webhook_id NOT IN (
+			SELECT
+				id
+			FROM
+				webhook
+		)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ type Driver interface {
 	Migrate(ctx context.Context) error
 	Vacuum(ctx context.Context) error
 	BackupTo(ctx context.Context, filename string) error
+	RestoreFrom(ctx context.Context, filename string) error
 
 	// current file is driver
 	GetCurrentDBSize(ctx context.Context) (int64, error)
@@ -40,11 +41,28 @@ type Driver interface {
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
 
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error
+
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
 	DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error
 
+	// MemoRelationTypeDefinition model related methods.
+	CreateMemoRelationTypeDefinition(ctx context.Context, create *MemoRelationTypeDefinition) (*MemoRelationTypeDefinition, error)
+	ListMemoRelationTypeDefinitions(ctx context.Context, find *FindMemoRelationTypeDefinition) ([]*MemoRelationTypeDefinition, error)
+	UpdateMemoRelationTypeDefinition(ctx context.Context, update *UpdateMemoRelationTypeDefinition) (*MemoRelationTypeDefinition, error)
+	DeleteMemoRelationTypeDefinition(ctx context.Context, delete *DeleteMemoRelationTypeDefinition) error
+
+	// MemoTag model related methods.
+	UpsertMemoTags(ctx context.Context, upsert *UpsertMemoTags) error
+	ListMemoTagCounts(ctx context.Context, find *FindMemoTag) ([]*MemoTagCount, error)
+	ListMemoTags(ctx context.Context, find *FindMemoTag) ([]*MemoTag, error)
+	ApplyTagRewrite(ctx context.Context, apply *ApplyTagRewrite) error
+
 	// MemoOrganizer model related methods.
 	UpsertMemoOrganizer(ctx context.Context, upsert *MemoOrganizer) (*MemoOrganizer, error)
 	ListMemoOrganizer(ctx context.Context, find *FindMemoOrganizer) ([]*MemoOrganizer, error)
@@ -92,4 +110,15 @@ type Driver interface {
 	ListWebhooks(ctx context.Context, find *FindWebhook) ([]*storepb.Webhook, error)
 	UpdateWebhook(ctx context.Context, update *UpdateWebhook) (*storepb.Webhook, error)
 	DeleteWebhook(ctx context.Context, delete *DeleteWebhook) error
+
+	// WebhookDelivery model related methods.
+	CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) (*WebhookDelivery, error)
+
+	// SavedSearch model related methods.
+	CreateSavedSearch(ctx context.Context, create *storepb.SavedSearch) (*storepb.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, find *FindSavedSearch) ([]*storepb.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, update *UpdateSavedSearch) (*storepb.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, delete *DeleteSavedSearch) error
 }
@@ -43,6 +43,8 @@ type Memo struct {
 
 	// Composed fields
 	Pinned bool
+	// Snippet is the highlighted excerpt of the content matched by FindMemo.SearchQuery.
+	Snippet string
 }
 
 type FindMemo struct {
@@ -59,6 +61,17 @@ type FindMemo struct {
 	VisibilityList []Visibility
 	Pinned         *bool
 	ExcludeContent bool
+	// Tag matches the memos with the tag or any of its sub tags, by the memo tag index.
+	// An alias of a tag matches the memos of the creator with the tag.
+	Tag *string
+	// SearchQuery is a full-text search query. It supports "quoted phrases",
+	// prefix* terms and the AND, OR and NOT operators, and orders the results by relevance.
+	// The terms match inside the words, so the CJK text without spaces is searchable. A query with a term
+	// shorter than three characters scans the content with the same operators, without relevance and snippets.
+	// The drivers without full-text search return ErrMemoSearchNotSupported.
+	SearchQuery *string
+	// Filter is a boolean expression on the memo fields, which is combined with the other conditions by AND.
+	Filter *MemoFilter
 
 	// Pagination
 	Limit            *int
@@ -74,6 +87,9 @@ type UpdateMemo struct {
 	RowStatus  *RowStatus
 	Content    *string
 	Visibility *Visibility
+	// SkipRevision writes the content without keeping the overwritten one as a revision,
+	// e.g. when the content of a new memo is completed.
+	SkipRevision bool
 }
 
 type DeleteMemo struct {
@@ -81,7 +97,17 @@ type DeleteMemo struct {
 }
 
 func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
-	return s.driver.CreateMemo(ctx, create)
+	memo, err := s.driver.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.UpsertMemoTags(ctx, memo.ID, memo.Content); err != nil {
+		return nil, err
+	}
+	if _, err := s.ReconcileMemoLinks(ctx, memo, ""); err != nil {
+		return nil, err
+	}
+	return memo, nil
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
@@ -102,7 +128,40 @@ func (s *Store) GetMemo(ctx context.Context, find *FindMemo) (*Memo, error) {
 }
 
 func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
-	return s.driver.UpdateMemo(ctx, update)
+	var memo *Memo
+	// Keep the content being overwritten as a revision so it can be restored later.
+	if update.Content != nil {
+		var err error
+		memo, err = s.GetMemo(ctx, &FindMemo{ID: &update.ID})
+		if err != nil {
+			return err
+		}
+		if memo != nil && memo.Content != *update.Content && !update.SkipRevision {
+			if _, err := s.driver.CreateMemoRevision(ctx, &MemoRevision{
+				CreatorID: memo.CreatorID,
+				MemoID:    memo.ID,
+				Content:   memo.Content,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	if err := s.driver.UpdateMemo(ctx, update); err != nil {
+		return err
+	}
+	if update.Content != nil {
+		if err := s.UpsertMemoTags(ctx, update.ID, *update.Content); err != nil {
+			return err
+		}
+	}
+	if memo != nil {
+		updatedMemo := *memo
+		updatedMemo.Content = *update.Content
+		if _, err := s.ReconcileMemoLinks(ctx, &updatedMemo, memo.Content); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
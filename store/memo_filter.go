@@ -0,0 +1,229 @@
+package store
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+// ErrMemoFilterNotSupported is returned by the drivers that can not run a memo filter.
+var ErrMemoFilterNotSupported = errors.New("memo filter is not supported")
+
+// ErrMemoSearchNotSupported is returned by the drivers that can not run a full-text search query.
+var ErrMemoSearchNotSupported = errors.New("full-text search is not supported")
+
+// MemoFilterKind is the kind of a node in a memo filter.
+type MemoFilterKind string
+
+const (
+	// MemoFilterAnd matches the memos matched by all of its children.
+	MemoFilterAnd MemoFilterKind = "AND"
+	// MemoFilterOr matches the memos matched by any of its children.
+	MemoFilterOr MemoFilterKind = "OR"
+	// MemoFilterNot matches the memos not matched by its only child.
+	MemoFilterNot MemoFilterKind = "NOT"
+	// MemoFilterCondition matches the memos whose field compares to the value with the operator.
+	MemoFilterCondition MemoFilterKind = "CONDITION"
+)
+
+// MemoFilterField is a field of the memos that can be filtered on.
+type MemoFilterField string
+
+const (
+	// MemoFilterVisibility is the visibility of the memo, compared to a Visibility.
+	MemoFilterVisibility MemoFilterField = "visibility"
+	// MemoFilterRowStatus is the row status of the memo, compared to a RowStatus.
+	MemoFilterRowStatus MemoFilterField = "row_status"
+	// MemoFilterCreatedTs is the creation time of the memo, compared to a unix timestamp.
+	MemoFilterCreatedTs MemoFilterField = "created_ts"
+	// MemoFilterUpdatedTs is the update time of the memo, compared to a unix timestamp.
+	MemoFilterUpdatedTs MemoFilterField = "updated_ts"
+	// MemoFilterTag matches the memos with the tag or any of its sub tags.
+	MemoFilterTag MemoFilterField = "tag"
+	// MemoFilterContentSearch matches the memos whose content contains the string.
+	MemoFilterContentSearch MemoFilterField = "content_search"
+	// MemoFilterPinned matches the pinned memos.
+	MemoFilterPinned MemoFilterField = "pinned"
+	// MemoFilterHasResource matches the memos with resources.
+	MemoFilterHasResource MemoFilterField = "has_resource"
+	// MemoFilterHasTask matches the memos with task list items.
+	MemoFilterHasTask MemoFilterField = "has_task"
+	// MemoFilterRelatedTo matches the memos related to the memo with the ID, in either direction.
+	MemoFilterRelatedTo MemoFilterField = "related_to"
+)
+
+// MemoFilterOperator is the operator of a memo filter condition.
+type MemoFilterOperator string
+
+const (
+	MemoFilterEqual          MemoFilterOperator = "=="
+	MemoFilterNotEqual       MemoFilterOperator = "!="
+	MemoFilterLess           MemoFilterOperator = "<"
+	MemoFilterLessOrEqual    MemoFilterOperator = "<="
+	MemoFilterGreater        MemoFilterOperator = ">"
+	MemoFilterGreaterOrEqual MemoFilterOperator = ">="
+	// MemoFilterIn matches when the field equals any value of the list.
+	MemoFilterIn MemoFilterOperator = "in"
+)
+
+// MemoFilter is a boolean expression on the memo fields, which the drivers compile to SQL.
+type MemoFilter struct {
+	Kind MemoFilterKind
+
+	// Children are the operands of AND, OR and NOT.
+	Children []*MemoFilter
+
+	// Field, Operator and Value are the condition.
+	// Value is a string, an int64 or a bool by the field, or a list of them for the in operator.
+	Field    MemoFilterField
+	Operator MemoFilterOperator
+	Value    any
+}
+
+// LowerMemoFilter returns a copy of the find with its filter lowered to the other fields, for the drivers without a filter compiler.
+// Only the AND of the visibility, row_status, created_ts, tag and content_search conditions can be lowered, and the others
+// return ErrMemoFilterNotSupported. The returned bool is false if the conditions contradict, so no memo can match.
+func LowerMemoFilter(find *FindMemo) (*FindMemo, bool, error) {
+	lowered := *find
+	lowered.Filter = nil
+	lowered.VisibilityList = slices.Clone(find.VisibilityList)
+	lowered.ContentSearch = slices.Clone(find.ContentSearch)
+	if find.Filter == nil {
+		return &lowered, true, nil
+	}
+	matchable, err := lowerMemoFilter(&lowered, find.Filter)
+	if err != nil {
+		return nil, false, err
+	}
+	return &lowered, matchable, nil
+}
+
+func lowerMemoFilter(find *FindMemo, filter *MemoFilter) (bool, error) {
+	switch filter.Kind {
+	case MemoFilterAnd:
+		matchable := true
+		for _, child := range filter.Children {
+			childMatchable, err := lowerMemoFilter(find, child)
+			if err != nil {
+				return false, err
+			}
+			matchable = matchable && childMatchable
+		}
+		return matchable, nil
+	case MemoFilterCondition:
+		return lowerMemoFilterCondition(find, filter)
+	default:
+		return false, errors.Wrapf(ErrMemoFilterNotSupported, "%s filter", filter.Kind)
+	}
+}
+
+func lowerMemoFilterCondition(find *FindMemo, filter *MemoFilter) (bool, error) {
+	unsupported := errors.Wrapf(ErrMemoFilterNotSupported, "%s %s", filter.Field, filter.Operator)
+	switch filter.Field {
+	case MemoFilterVisibility:
+		values, ok := getMemoFilterValues[string](filter)
+		if !ok {
+			return false, unsupported
+		}
+		visibilityList := []Visibility{}
+		for _, value := range values {
+			visibility := Visibility(value)
+			// The visibilities are intersected with the ones of the find, e.g. the public ones for the visitors.
+			if len(find.VisibilityList) == 0 || slices.Contains(find.VisibilityList, visibility) {
+				visibilityList = append(visibilityList, visibility)
+			}
+		}
+		find.VisibilityList = visibilityList
+		return len(visibilityList) > 0, nil
+	case MemoFilterRowStatus:
+		value, ok := filter.Value.(string)
+		if !ok || filter.Operator != MemoFilterEqual {
+			return false, unsupported
+		}
+		rowStatus := RowStatus(value)
+		if find.RowStatus != nil && *find.RowStatus != rowStatus {
+			return false, nil
+		}
+		find.RowStatus = &rowStatus
+		return true, nil
+	case MemoFilterCreatedTs:
+		value, ok := filter.Value.(int64)
+		if !ok {
+			return false, unsupported
+		}
+		// The created_ts fields of the find are exclusive bounds.
+		before, after := find.CreatedTsBefore, find.CreatedTsAfter
+		switch filter.Operator {
+		case MemoFilterEqual:
+			before, after = minMemoFilterTs(before, value+1), maxMemoFilterTs(after, value-1)
+		case MemoFilterLess:
+			before = minMemoFilterTs(before, value)
+		case MemoFilterLessOrEqual:
+			before = minMemoFilterTs(before, value+1)
+		case MemoFilterGreater:
+			after = maxMemoFilterTs(after, value)
+		case MemoFilterGreaterOrEqual:
+			after = maxMemoFilterTs(after, value-1)
+		default:
+			return false, unsupported
+		}
+		find.CreatedTsBefore, find.CreatedTsAfter = before, after
+		return before == nil || after == nil || *after+1 < *before, nil
+	case MemoFilterTag:
+		value, ok := filter.Value.(string)
+		// The find has a single tag, so only one tag condition can be lowered.
+		if !ok || filter.Operator != MemoFilterEqual || find.Tag != nil {
+			return false, unsupported
+		}
+		find.Tag = &value
+		return true, nil
+	case MemoFilterContentSearch:
+		value, ok := filter.Value.(string)
+		if !ok || filter.Operator != MemoFilterEqual {
+			return false, unsupported
+		}
+		find.ContentSearch = append(find.ContentSearch, value)
+		return true, nil
+	default:
+		return false, unsupported
+	}
+}
+
+// getMemoFilterValues returns the values of an equal or in condition.
+func getMemoFilterValues[T any](filter *MemoFilter) ([]T, bool) {
+	switch filter.Operator {
+	case MemoFilterEqual:
+		value, ok := filter.Value.(T)
+		return []T{value}, ok
+	case MemoFilterIn:
+		list, ok := filter.Value.([]any)
+		if !ok {
+			return nil, false
+		}
+		values := []T{}
+		for _, item := range list {
+			value, ok := item.(T)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, value)
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+func minMemoFilterTs(ts *int64, value int64) *int64 {
+	if ts != nil && *ts < value {
+		return ts
+	}
+	return &value
+}
+
+func maxMemoFilterTs(ts *int64, value int64) *int64 {
+	if ts != nil && *ts > value {
+		return ts
+	}
+	return &value
+}
@@ -0,0 +1,166 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/usememos/memos/plugin/gomark"
+)
+
+// memoLinkPrefix is the prefix of the wiki links to a memo by id, e.g. [[memo:123]].
+const memoLinkPrefix = "memo:"
+
+// ReconcileMemoLinks keeps the reference relations of the memo in sync with the wiki links in its content.
+// The relations of the links removed since the previous content are deleted, and the missing relations of the links
+// in the content are created, so the other reference relations of the memo are kept.
+// It returns the link targets which do not resolve to a memo visible to the creator of the memo.
+// CreateMemo and UpdateMemo reconcile the links of the content they write.
+func (s *Store) ReconcileMemoLinks(ctx context.Context, memo *Memo, previousContent string) ([]string, error) {
+	linkedMemoIDs, unresolvedTargets, err := s.resolveMemoLinks(ctx, memo, memo.Content)
+	if err != nil {
+		return nil, err
+	}
+	previousLinkedMemoIDs, _, err := s.resolveMemoLinks(ctx, memo, previousContent)
+	if err != nil {
+		return nil, err
+	}
+
+	referenceType := MemoRelationReference
+	memoRelations, err := s.ListMemoRelations(ctx, &FindMemoRelation{
+		MemoID: &memo.ID,
+		Type:   &referenceType,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	relatedMemoIDs := []int32{}
+	for _, memoRelation := range memoRelations {
+		relatedMemoIDs = append(relatedMemoIDs, memoRelation.RelatedMemoID)
+	}
+
+	for _, memoID := range previousLinkedMemoIDs {
+		memoID := memoID
+		if slices.Contains(linkedMemoIDs, memoID) || !slices.Contains(relatedMemoIDs, memoID) {
+			continue
+		}
+		if err := s.DeleteMemoRelation(ctx, &DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &memoID,
+			Type:          &referenceType,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to delete memo relation")
+		}
+	}
+	for _, memoID := range linkedMemoIDs {
+		if slices.Contains(relatedMemoIDs, memoID) {
+			continue
+		}
+		if _, err := s.UpsertMemoRelation(ctx, &MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: memoID,
+			Type:          MemoRelationReference,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to upsert memo relation")
+		}
+	}
+	return unresolvedTargets, nil
+}
+
+// ListUnresolvedMemoLinks returns the targets of the wiki links in the memo content which do not resolve to a memo visible to its creator.
+func (s *Store) ListUnresolvedMemoLinks(ctx context.Context, memo *Memo) ([]string, error) {
+	_, unresolvedTargets, err := s.resolveMemoLinks(ctx, memo, memo.Content)
+	if err != nil {
+		return nil, err
+	}
+	return unresolvedTargets, nil
+}
+
+// resolveMemoLinks returns the sorted ids of the memos linked by the wiki links in the content, and the targets of the unresolved links.
+// A [[memo:123]] link resolves to the memo if it is visible to the creator of the memo, and a [[Title text]] link
+// resolves to the latest memo of the creator with the title. The links to the memo itself are ignored.
+func (s *Store) resolveMemoLinks(ctx context.Context, memo *Memo, content string) ([]int32, []string, error) {
+	wikiLinks, err := gomark.ParseWikiLinks(content)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse memo content")
+	}
+
+	linkedMemoIDs, unresolvedTargets := []int32{}, []string{}
+	resolvedTargets := map[string]bool{}
+	for _, wikiLink := range wikiLinks {
+		if resolvedTargets[wikiLink.Target] {
+			continue
+		}
+		resolvedTargets[wikiLink.Target] = true
+
+		linkedMemo, err := s.resolveMemoLink(ctx, memo, wikiLink.Target)
+		if err != nil {
+			return nil, nil, err
+		}
+		if linkedMemo == nil {
+			unresolvedTargets = append(unresolvedTargets, wikiLink.Target)
+			continue
+		}
+		if linkedMemo.ID != memo.ID && !slices.Contains(linkedMemoIDs, linkedMemo.ID) {
+			linkedMemoIDs = append(linkedMemoIDs, linkedMemo.ID)
+		}
+	}
+	sort.Slice(linkedMemoIDs, func(i, j int) bool {
+		return linkedMemoIDs[i] < linkedMemoIDs[j]
+	})
+	return linkedMemoIDs, unresolvedTargets, nil
+}
+
+func (s *Store) resolveMemoLink(ctx context.Context, memo *Memo, target string) (*Memo, error) {
+	if value, ok := strings.CutPrefix(target, memoLinkPrefix); ok {
+		id, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, nil
+		}
+		memoID := int32(id)
+		linkedMemo, err := s.GetMemo(ctx, &FindMemo{
+			ID:             &memoID,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if linkedMemo == nil || (linkedMemo.CreatorID != memo.CreatorID && linkedMemo.Visibility == Private) {
+			return nil, nil
+		}
+		return linkedMemo, nil
+	}
+
+	// The content search narrows down the candidates, which are ordered by created_ts descending.
+	memos, err := s.ListMemos(ctx, &FindMemo{
+		CreatorID:     &memo.CreatorID,
+		ContentSearch: []string{target},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	for _, candidate := range memos {
+		if candidate.ID != memo.ID && strings.EqualFold(getMemoTitle(candidate.Content), target) {
+			return candidate, nil
+		}
+	}
+	return nil, nil
+}
+
+// getMemoTitle returns the title of the memo content, i.e. its first non-empty line without the heading marks.
+func getMemoTitle(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if heading := strings.TrimLeft(line, "#"); heading != line && strings.HasPrefix(heading, " ") {
+			line = strings.TrimSpace(heading)
+		}
+		if line != "" {
+			return line
+		}
+	}
+	return ""
+}
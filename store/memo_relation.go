@@ -23,6 +23,10 @@ type FindMemoRelation struct {
 	MemoID        *int32
 	RelatedMemoID *int32
 	Type          *MemoRelationType
+	// TypeList matches the relations of any of the types.
+	TypeList []MemoRelationType
+	// CreatorID matches the relations from the memos of the user.
+	CreatorID *int32
 }
 
 type DeleteMemoRelation struct {
@@ -31,7 +35,41 @@ type DeleteMemoRelation struct {
 	Type          *MemoRelationType
 }
 
+// UpsertMemoRelation creates the relation unless it exists, after validating its type.
+// The relations of an undirected custom type are also created in the reverse direction.
 func (s *Store) UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error) {
+	definition, err := s.validateMemoRelation(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	memoRelation, err := s.upsertMemoRelation(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if definition != nil && !definition.Directed {
+		if _, err := s.upsertMemoRelation(ctx, &MemoRelation{
+			MemoID:        create.RelatedMemoID,
+			RelatedMemoID: create.MemoID,
+			Type:          create.Type,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return memoRelation, nil
+}
+
+func (s *Store) upsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error) {
+	list, err := s.driver.ListMemoRelations(ctx, &FindMemoRelation{
+		MemoID:        &create.MemoID,
+		RelatedMemoID: &create.RelatedMemoID,
+		Type:          &create.Type,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) > 0 {
+		return list[0], nil
+	}
 	return s.driver.UpsertMemoRelation(ctx, create)
 }
 
@@ -39,6 +77,30 @@ func (s *Store) ListMemoRelations(ctx context.Context, find *FindMemoRelation) (
 	return s.driver.ListMemoRelations(ctx, find)
 }
 
+// DeleteMemoRelation deletes the matching relations, and the reverse relation of an undirected custom type.
 func (s *Store) DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error {
-	return s.driver.DeleteMemoRelation(ctx, delete)
+	if err := s.driver.DeleteMemoRelation(ctx, delete); err != nil {
+		return err
+	}
+	if delete.MemoID == nil || delete.RelatedMemoID == nil || delete.Type == nil || IsBuiltinMemoRelationType(*delete.Type) {
+		return nil
+	}
+
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: delete.MemoID, ExcludeContent: true})
+	if err != nil || memo == nil {
+		return err
+	}
+	name := string(*delete.Type)
+	definition, err := s.GetMemoRelationTypeDefinition(ctx, &FindMemoRelationTypeDefinition{
+		CreatorID: &memo.CreatorID,
+		Name:      &name,
+	})
+	if err != nil || definition == nil || definition.Directed {
+		return err
+	}
+	return s.driver.DeleteMemoRelation(ctx, &DeleteMemoRelation{
+		MemoID:        delete.RelatedMemoID,
+		RelatedMemoID: delete.MemoID,
+		Type:          delete.Type,
+	})
 }
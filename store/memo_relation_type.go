@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// memoRelationTypeNameMatcher matches the names of the custom relation types, e.g. "supports" or "source-of".
+var memoRelationTypeNameMatcher = regexp.MustCompile(`^[a-z][a-z0-9-]{0,31}$`)
+
+// MemoRelationTypeDefinition is a custom memo relation type of a user, e.g. "supports" with the inverse label "supported by".
+// The relations of a custom type are only allowed between the memos of its creator.
+type MemoRelationTypeDefinition struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+
+	// Name is the type of the relations, which is unique for the creator.
+	Name string
+	// InverseName is the label of the relation seen from the related memo, e.g. "supported by".
+	InverseName string
+	// Directed is false for the symmetric types, whose relations are kept in both directions.
+	Directed bool
+}
+
+type FindMemoRelationTypeDefinition struct {
+	ID        *int32
+	CreatorID *int32
+	Name      *string
+}
+
+type UpdateMemoRelationTypeDefinition struct {
+	ID          int32
+	InverseName *string
+}
+
+type DeleteMemoRelationTypeDefinition struct {
+	ID int32
+}
+
+// IsBuiltinMemoRelationType returns whether the type is one of the types available to all the users.
+func IsBuiltinMemoRelationType(relationType MemoRelationType) bool {
+	return relationType == MemoRelationReference || relationType == MemoRelationComment
+}
+
+// IsValidMemoRelationTypeName returns whether the name can be used for a custom relation type.
+func IsValidMemoRelationTypeName(name string) bool {
+	return memoRelationTypeNameMatcher.MatchString(name)
+}
+
+func (s *Store) CreateMemoRelationTypeDefinition(ctx context.Context, create *MemoRelationTypeDefinition) (*MemoRelationTypeDefinition, error) {
+	if !IsValidMemoRelationTypeName(create.Name) {
+		return nil, errors.Errorf("invalid memo relation type name %q", create.Name)
+	}
+	return s.driver.CreateMemoRelationTypeDefinition(ctx, create)
+}
+
+// ListMemoRelationTypeDefinitions returns the custom relation types ordered by name.
+func (s *Store) ListMemoRelationTypeDefinitions(ctx context.Context, find *FindMemoRelationTypeDefinition) ([]*MemoRelationTypeDefinition, error) {
+	return s.driver.ListMemoRelationTypeDefinitions(ctx, find)
+}
+
+func (s *Store) GetMemoRelationTypeDefinition(ctx context.Context, find *FindMemoRelationTypeDefinition) (*MemoRelationTypeDefinition, error) {
+	list, err := s.ListMemoRelationTypeDefinitions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateMemoRelationTypeDefinition(ctx context.Context, update *UpdateMemoRelationTypeDefinition) (*MemoRelationTypeDefinition, error) {
+	return s.driver.UpdateMemoRelationTypeDefinition(ctx, update)
+}
+
+// DeleteMemoRelationTypeDefinition deletes the custom relation type with all the relations of the type.
+func (s *Store) DeleteMemoRelationTypeDefinition(ctx context.Context, delete *DeleteMemoRelationTypeDefinition) error {
+	return s.driver.DeleteMemoRelationTypeDefinition(ctx, delete)
+}
+
+// ErrInvalidMemoRelation is returned when a relation uses an unknown type, or a custom type between the memos it can't relate.
+var ErrInvalidMemoRelation = errors.New("invalid memo relation")
+
+// validateMemoRelation checks the type of the relation, and returns the definition of its custom type.
+// The definition is nil for the builtin types, which are allowed between any memos.
+func (s *Store) validateMemoRelation(ctx context.Context, relation *MemoRelation) (*MemoRelationTypeDefinition, error) {
+	if IsBuiltinMemoRelationType(relation.Type) {
+		return nil, nil
+	}
+
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &relation.MemoID, ExcludeContent: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, errors.Wrapf(ErrInvalidMemoRelation, "memo %d not found", relation.MemoID)
+	}
+	name := string(relation.Type)
+	definition, err := s.GetMemoRelationTypeDefinition(ctx, &FindMemoRelationTypeDefinition{
+		CreatorID: &memo.CreatorID,
+		Name:      &name,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo relation type")
+	}
+	if definition == nil {
+		return nil, errors.Wrapf(ErrInvalidMemoRelation, "unknown memo relation type %q", relation.Type)
+	}
+	relatedMemo, err := s.GetMemo(ctx, &FindMemo{ID: &relation.RelatedMemoID, ExcludeContent: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get related memo")
+	}
+	if relatedMemo == nil || relatedMemo.CreatorID != memo.CreatorID {
+		return nil, errors.Wrapf(ErrInvalidMemoRelation, "memo relation type %q only relates the memos of its creator", relation.Type)
+	}
+	return definition, nil
+}
@@ -0,0 +1,144 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/gomark"
+)
+
+// memoTagIndexedSettingName is the system setting marking that the tags of the existing memos are indexed.
+const memoTagIndexedSettingName = "memo-tag-indexed"
+
+// MemoTag is a tag of a memo in the memo tag index.
+type MemoTag struct {
+	MemoID int32
+	Tag    string
+	// Implicit is true for the parent tags of the tags in the content, e.g. `work` of `#work/project`.
+	Implicit bool
+
+	// MemoCreatedTs is the created_ts of the memo, only set by ListMemoTags.
+	MemoCreatedTs int64
+}
+
+type UpsertMemoTags struct {
+	MemoID int32
+	// Tags replace all the indexed tags of the memo.
+	Tags []*MemoTag
+}
+
+type FindMemoTag struct {
+	CreatorID *int32
+	RowStatus *RowStatus
+	// ExcludeImplicit only counts the tags written in the memo contents.
+	ExcludeImplicit bool
+}
+
+type MemoTagCount struct {
+	Tag       string
+	MemoCount int
+}
+
+// ExtractMemoTags returns the sorted tags of the memo content, found by the gomark parser.
+func ExtractMemoTags(content string) ([]string, error) {
+	tagNodes, err := gomark.ParseTags(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse memo content")
+	}
+
+	tagMapSet := make(map[string]bool)
+	for _, tagNode := range tagNodes {
+		tagMapSet[tagNode.Content] = true
+	}
+	tags := []string{}
+	for tag := range tagMapSet {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+// UpsertMemoTags re-indexes the tags of the memo from its content.
+func (s *Store) UpsertMemoTags(ctx context.Context, memoID int32, content string) error {
+	memoTags, err := buildMemoTags(memoID, content)
+	if err != nil {
+		return err
+	}
+	return s.driver.UpsertMemoTags(ctx, &UpsertMemoTags{
+		MemoID: memoID,
+		Tags:   memoTags,
+	})
+}
+
+// buildMemoTags returns the index entries of the memo content, i.e. its tags and their implicit parent tags.
+func buildMemoTags(memoID int32, content string) ([]*MemoTag, error) {
+	tags, err := ExtractMemoTags(content)
+	if err != nil {
+		return nil, err
+	}
+
+	memoTags := []*MemoTag{}
+	explicitTags := make(map[string]bool)
+	for _, tag := range tags {
+		explicitTags[tag] = true
+		memoTags = append(memoTags, &MemoTag{MemoID: memoID, Tag: tag})
+	}
+	implicitTags := make(map[string]bool)
+	for _, tag := range tags {
+		parts := strings.Split(tag, "/")
+		for i := 1; i < len(parts); i++ {
+			parent := strings.Join(parts[:i], "/")
+			if parent == "" || explicitTags[parent] || implicitTags[parent] {
+				continue
+			}
+			implicitTags[parent] = true
+			memoTags = append(memoTags, &MemoTag{MemoID: memoID, Tag: parent, Implicit: true})
+		}
+	}
+	return memoTags, nil
+}
+
+// ListMemoTagCounts returns the tags with the number of memos having them, sorted by tag.
+// The parent tags also count the memos of their sub tags, unless ExcludeImplicit is set.
+func (s *Store) ListMemoTagCounts(ctx context.Context, find *FindMemoTag) ([]*MemoTagCount, error) {
+	return s.driver.ListMemoTagCounts(ctx, find)
+}
+
+// ListMemoTags returns the indexed tags of the memos ordered by memo id.
+func (s *Store) ListMemoTags(ctx context.Context, find *FindMemoTag) ([]*MemoTag, error) {
+	return s.driver.ListMemoTags(ctx, find)
+}
+
+// BackfillMemoTags indexes the tags of all the memos once, for the memos created before the memo tag index.
+func (s *Store) BackfillMemoTags(ctx context.Context) error {
+	setting, err := s.GetSystemSetting(ctx, &FindSystemSetting{
+		Name: memoTagIndexedSettingName,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo tag index setting")
+	}
+	if setting != nil {
+		return nil
+	}
+
+	memos, err := s.ListMemos(ctx, &FindMemo{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	for _, memo := range memos {
+		if err := s.UpsertMemoTags(ctx, memo.ID, memo.Content); err != nil {
+			return errors.Wrapf(err, "failed to index tags of memo %d", memo.ID)
+		}
+	}
+
+	if _, err := s.UpsertSystemSetting(ctx, &SystemSetting{
+		Name:  memoTagIndexedSettingName,
+		Value: "true",
+	}); err != nil {
+		return errors.Wrap(err, "failed to upsert memo tag index setting")
+	}
+	return nil
+}
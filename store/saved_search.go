@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+type FindSavedSearch struct {
+	ID        *int32
+	CreatorID *int32
+	Name      *string
+}
+
+type UpdateSavedSearch struct {
+	ID             int32
+	UpdatedTs      *int64
+	Name           *string
+	Filter         *string
+	Order          *storepb.SavedSearch_Order
+	PinnedPosition *int32
+}
+
+type DeleteSavedSearch struct {
+	ID int32
+}
+
+func (s *Store) CreateSavedSearch(ctx context.Context, create *storepb.SavedSearch) (*storepb.SavedSearch, error) {
+	return s.driver.CreateSavedSearch(ctx, create)
+}
+
+// ListSavedSearches returns the saved searches ordered by their pinned positions, with the unpinned ones last by name.
+func (s *Store) ListSavedSearches(ctx context.Context, find *FindSavedSearch) ([]*storepb.SavedSearch, error) {
+	return s.driver.ListSavedSearches(ctx, find)
+}
+
+func (s *Store) GetSavedSearch(ctx context.Context, find *FindSavedSearch) (*storepb.SavedSearch, error) {
+	list, err := s.ListSavedSearches(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateSavedSearch(ctx context.Context, update *UpdateSavedSearch) (*storepb.SavedSearch, error) {
+	return s.driver.UpdateSavedSearch(ctx, update)
+}
+
+func (s *Store) DeleteSavedSearch(ctx context.Context, delete *DeleteSavedSearch) error {
+	return s.driver.DeleteSavedSearch(ctx, delete)
+}
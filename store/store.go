@@ -15,6 +15,9 @@ type Store struct {
 	userCache          sync.Map // map[int]*User
 	userSettingCache   sync.Map // map[string]*UserSetting
 	idpCache           sync.Map // map[int]*IdentityProvider
+	telegramLinkCodes  sync.Map // map[string]*telegramLinkCode
+	// quiesce holds the guarded database accesses while the database is swapped.
+	quiesce sync.RWMutex
 }
 
 // New creates a new instance of Store.
@@ -25,10 +28,45 @@ func New(driver Driver, profile *profile.Profile) *Store {
 	}
 }
 
+// Guard runs fn unless the database is being swapped by Quiesce, in which case fn waits for it to finish.
+// The entry points accessing the database run with it, i.e. the requests, the telegram bot and the webhook dispatcher.
+// It must not be nested, as a pending Quiesce blocks the nested call.
+func (s *Store) Guard(fn func() error) error {
+	s.quiesce.RLock()
+	defer s.quiesce.RUnlock()
+	return fn()
+}
+
+// Quiesce runs fn after the guarded accesses finish, and holds the new ones until it returns.
+func (s *Store) Quiesce(fn func() error) error {
+	s.quiesce.Lock()
+	defer s.quiesce.Unlock()
+	return fn()
+}
+
 func (s *Store) BackupTo(ctx context.Context, filename string) error {
 	return s.driver.BackupTo(ctx, filename)
 }
 
+// RestoreFrom replaces the database with the given backup file, drops the cached data and migrates the tag data if needed.
+func (s *Store) RestoreFrom(ctx context.Context, filename string) error {
+	if err := s.driver.RestoreFrom(ctx, filename); err != nil {
+		return err
+	}
+
+	for _, cache := range []*sync.Map{&s.systemSettingCache, &s.userCache, &s.userSettingCache, &s.idpCache} {
+		cache.Range(func(key, _ any) bool {
+			cache.Delete(key)
+			return true
+		})
+	}
+	// The backup may be from a version without the memo tag index or the tag metadata.
+	if err := s.BackfillMemoTags(ctx); err != nil {
+		return err
+	}
+	return s.MigrateFavoriteTags(ctx)
+}
+
 func (s *Store) Vacuum(ctx context.Context) error {
 	return s.driver.Vacuum(ctx)
 }
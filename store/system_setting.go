@@ -15,7 +15,13 @@ type FindSystemSetting struct {
 }
 
 func (s *Store) UpsertSystemSetting(ctx context.Context, upsert *SystemSetting) (*SystemSetting, error) {
-	return s.driver.UpsertSystemSetting(ctx, upsert)
+	systemSetting, err := s.driver.UpsertSystemSetting(ctx, upsert)
+	if err != nil {
+		return nil, err
+	}
+
+	s.systemSettingCache.Store(systemSetting.Name, systemSetting)
+	return systemSetting, nil
 }
 
 func (s *Store) ListSystemSettings(ctx context.Context, find *FindSystemSetting) ([]*SystemSetting, error) {
@@ -2,15 +2,29 @@ package store
 
 import (
 	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
+// tagFavoriteMigratedSettingName is the system setting marking that the fav_tag user settings are migrated to the tags.
+const tagFavoriteMigratedSettingName = "tag-favorite-migrated"
+
 type Tag struct {
 	Name      string
 	CreatorID int32
+
+	// Payload and Aliases are the metadata of the tag.
+	Payload *storepb.TagPayload
+	// Aliases are the other names of the tag, which match the memos with the tag when searched.
+	Aliases []string
 }
 
 type FindTag struct {
 	CreatorID int32
+	Name      *string
 }
 
 type DeleteTag struct {
@@ -18,6 +32,8 @@ type DeleteTag struct {
 	CreatorID int32
 }
 
+// UpsertTag creates the tag if it does not exist.
+// The metadata of an existing tag is replaced if Payload is set, and kept otherwise.
 func (s *Store) UpsertTag(ctx context.Context, upsert *Tag) (*Tag, error) {
 	return s.driver.UpsertTag(ctx, upsert)
 }
@@ -26,6 +42,94 @@ func (s *Store) ListTags(ctx context.Context, find *FindTag) ([]*Tag, error) {
 	return s.driver.ListTags(ctx, find)
 }
 
+func (s *Store) GetTag(ctx context.Context, find *FindTag) (*Tag, error) {
+	list, err := s.ListTags(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
 func (s *Store) DeleteTag(ctx context.Context, delete *DeleteTag) error {
 	return s.driver.DeleteTag(ctx, delete)
 }
+
+// SetFavoriteTags marks exactly the given tags of the creator as favorite, creating the missing ones.
+func (s *Store) SetFavoriteTags(ctx context.Context, creatorID int32, names []string) error {
+	tags, err := s.ListTags(ctx, &FindTag{
+		CreatorID: creatorID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list tags")
+	}
+	favoriteMap := make(map[string]bool)
+	for _, name := range names {
+		favoriteMap[name] = true
+	}
+	for _, tag := range tags {
+		if tag.Payload.Favorite != favoriteMap[tag.Name] {
+			tag.Payload.Favorite = favoriteMap[tag.Name]
+			if _, err := s.UpsertTag(ctx, tag); err != nil {
+				return errors.Wrapf(err, "failed to update tag %s", tag.Name)
+			}
+		}
+		delete(favoriteMap, tag.Name)
+	}
+	for _, name := range names {
+		if !favoriteMap[name] {
+			continue
+		}
+		delete(favoriteMap, name)
+		if _, err := s.UpsertTag(ctx, &Tag{
+			Name:      name,
+			CreatorID: creatorID,
+			Payload:   &storepb.TagPayload{Favorite: true},
+			Aliases:   []string{},
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create tag %s", name)
+		}
+	}
+	return nil
+}
+
+// MigrateFavoriteTags marks the tags in the comma separated fav_tag user settings as favorite once.
+func (s *Store) MigrateFavoriteTags(ctx context.Context) error {
+	setting, err := s.GetSystemSetting(ctx, &FindSystemSetting{
+		Name: tagFavoriteMigratedSettingName,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get favorite tag migration setting")
+	}
+	if setting != nil {
+		return nil
+	}
+
+	userSettings, err := s.ListUserSettingsV1(ctx, &FindUserSetting{
+		Key: storepb.UserSettingKey_USER_SETTING_FAV_TAG,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list user settings")
+	}
+	for _, userSetting := range userSettings {
+		names := []string{}
+		for _, name := range strings.Split(userSetting.GetFavTag(), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		if err := s.SetFavoriteTags(ctx, userSetting.UserId, names); err != nil {
+			return errors.Wrapf(err, "failed to migrate favorite tags of user %d", userSetting.UserId)
+		}
+	}
+
+	if _, err := s.UpsertSystemSetting(ctx, &SystemSetting{
+		Name:  tagFavoriteMigratedSettingName,
+		Value: "true",
+	}); err != nil {
+		return errors.Wrap(err, "failed to upsert favorite tag migration setting")
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/gomark"
+)
+
+// ErrTagRewriteConflict is returned when a memo is changed while its tags are rewritten, so no memo is rewritten.
+var ErrTagRewriteConflict = errors.New("memo changed during the tag rewrite")
+
+// RewriteTag renames, merges or deletes tags in the memos of a user.
+type RewriteTag struct {
+	CreatorID int32
+	// SourceTags are replaced with TargetTag, or removed from the memos if TargetTag is empty.
+	SourceTags []string
+	TargetTag  string
+	// IncludeSubTags also moves the sub tags of the source tags, e.g. `a/b` to `c/b` when `a` is renamed to `c`.
+	IncludeSubTags bool
+	// DryRun only finds the memos to rewrite without changing them.
+	DryRun bool
+}
+
+// MemoContentRewrite is the content of a memo rewritten by a tag operation.
+type MemoContentRewrite struct {
+	MemoID    int32
+	CreatorID int32
+	// PreviousContent is kept as a revision of the memo, whose content must still be it when the rewrite is applied.
+	PreviousContent string
+	Content         string
+	Tags            []*MemoTag
+}
+
+type ApplyTagRewrite struct {
+	CreatorID  int32
+	Memos      []*MemoContentRewrite
+	TagRenames []*TagRename
+}
+
+// TagRename moves a tag of the creator with its metadata to a new name, or deletes it if To is empty.
+type TagRename struct {
+	From string
+	To   string
+}
+
+// RewriteTag rewrites the tag nodes of the memos in a single transaction and returns the ids of the rewritten memos.
+func (s *Store) RewriteTag(ctx context.Context, rewrite *RewriteTag) ([]int32, error) {
+	if len(rewrite.SourceTags) == 0 {
+		return nil, errors.New("source tags are required")
+	}
+	for _, tag := range rewrite.SourceTags {
+		if !IsValidTag(tag) {
+			return nil, errors.Errorf("invalid tag %q", tag)
+		}
+	}
+	if rewrite.TargetTag != "" && !IsValidTag(rewrite.TargetTag) {
+		return nil, errors.Errorf("invalid tag %q", rewrite.TargetTag)
+	}
+	rewriteTag := func(tag string) string {
+		for _, source := range rewrite.SourceTags {
+			if tag == source {
+				return rewrite.TargetTag
+			}
+			if rewrite.IncludeSubTags && isSubTag(tag, source) {
+				if rewrite.TargetTag == "" {
+					return ""
+				}
+				return rewrite.TargetTag + strings.TrimPrefix(tag, source)
+			}
+		}
+		return tag
+	}
+
+	// The memo tag index finds the memos with the source tags and their sub tags.
+	memoMap := make(map[int32]*Memo)
+	for _, source := range rewrite.SourceTags {
+		source := source
+		memos, err := s.ListMemos(ctx, &FindMemo{
+			CreatorID: &rewrite.CreatorID,
+			Tag:       &source,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memos")
+		}
+		for _, memo := range memos {
+			memoMap[memo.ID] = memo
+		}
+	}
+	memoIDs := []int32{}
+	for id := range memoMap {
+		memoIDs = append(memoIDs, id)
+	}
+	sort.Slice(memoIDs, func(i, j int) bool {
+		return memoIDs[i] < memoIDs[j]
+	})
+
+	apply := &ApplyTagRewrite{
+		CreatorID: rewrite.CreatorID,
+	}
+	rewrittenMemoIDs := []int32{}
+	for _, id := range memoIDs {
+		memo := memoMap[id]
+		content, err := gomark.RewriteTags(memo.Content, rewriteTag)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to rewrite tags of memo %d", memo.ID)
+		}
+		if content == memo.Content {
+			continue
+		}
+		memoTags, err := buildMemoTags(memo.ID, content)
+		if err != nil {
+			return nil, err
+		}
+		rewrittenMemoIDs = append(rewrittenMemoIDs, memo.ID)
+		apply.Memos = append(apply.Memos, &MemoContentRewrite{
+			MemoID:          memo.ID,
+			CreatorID:       memo.CreatorID,
+			PreviousContent: memo.Content,
+			Content:         content,
+			Tags:            memoTags,
+		})
+	}
+	if rewrite.DryRun {
+		return rewrittenMemoIDs, nil
+	}
+
+	tags, err := s.ListTags(ctx, &FindTag{
+		CreatorID: rewrite.CreatorID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tags")
+	}
+	for _, tag := range tags {
+		if name := rewriteTag(tag.Name); name != tag.Name {
+			apply.TagRenames = append(apply.TagRenames, &TagRename{From: tag.Name, To: name})
+		}
+	}
+	if err := s.driver.ApplyTagRewrite(ctx, apply); err != nil {
+		return nil, err
+	}
+	return rewrittenMemoIDs, nil
+}
+
+// IsValidTag returns whether the tag is written as a single tag node, e.g. `work/project` but not `work project`.
+func IsValidTag(tag string) bool {
+	tags, err := ExtractMemoTags("#" + tag)
+	return err == nil && len(tags) == 1 && tags[0] == tag
+}
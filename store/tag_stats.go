@@ -0,0 +1,188 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type FindTagStats struct {
+	CreatorID int32
+	// Exact only counts the memos with the tag itself, while a parent tag also counts the memos of its sub tags otherwise.
+	Exact bool
+	// RootTag is the tag of the radial tree, which is only built if it is set.
+	RootTag string
+}
+
+type TagStats struct {
+	// Usages are sorted by tag.
+	Usages []*TagUsage
+	// CoOccurrences are sorted by memo count in descending order, with Tag before OtherTag alphabetically.
+	CoOccurrences []*TagCoOccurrence
+	// Tree is the root tag with its sub tags.
+	Tree *TagTreeNode
+	// Neighbors are the co-occurrences of the root tag, with the root tag as Tag.
+	Neighbors []*TagCoOccurrence
+}
+
+type TagUsage struct {
+	Tag         string
+	MemoCount   int
+	FirstUsedTs int64
+	LastUsedTs  int64
+	// MonthlyUsages are sorted by month.
+	MonthlyUsages []*TagMonthlyUsage
+}
+
+type TagMonthlyUsage struct {
+	// Month is formatted as YYYY-MM in UTC.
+	Month     string
+	MemoCount int
+}
+
+type TagCoOccurrence struct {
+	Tag       string
+	OtherTag  string
+	MemoCount int
+}
+
+type TagTreeNode struct {
+	Tag       string
+	MemoCount int
+	Children  []*TagTreeNode
+}
+
+// GetTagStats returns the usages and co-occurrences of the tags in the normal memos of the creator.
+// A tag never co-occurs with its own parent tags, which are implied by it.
+func (s *Store) GetTagStats(ctx context.Context, find *FindTagStats) (*TagStats, error) {
+	normalRowStatus := Normal
+	memoTags, err := s.ListMemoTags(ctx, &FindMemoTag{
+		CreatorID:       &find.CreatorID,
+		RowStatus:       &normalRowStatus,
+		ExcludeImplicit: find.Exact,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo tags")
+	}
+
+	usageMap := make(map[string]*TagUsage)
+	monthlyCountMap := make(map[string]map[string]int)
+	coOccurrenceMap := make(map[[2]string]int)
+	// The memo tags are ordered by memo id, so the tags of each memo are consecutive.
+	for start := 0; start < len(memoTags); {
+		end := start
+		for end < len(memoTags) && memoTags[end].MemoID == memoTags[start].MemoID {
+			end++
+		}
+		createdTs := memoTags[start].MemoCreatedTs
+		month := time.Unix(createdTs, 0).UTC().Format("2006-01")
+		for i := start; i < end; i++ {
+			tag := memoTags[i].Tag
+			usage, ok := usageMap[tag]
+			if !ok {
+				usage = &TagUsage{Tag: tag, FirstUsedTs: createdTs, LastUsedTs: createdTs}
+				usageMap[tag] = usage
+				monthlyCountMap[tag] = make(map[string]int)
+			}
+			usage.MemoCount++
+			usage.FirstUsedTs = min(usage.FirstUsedTs, createdTs)
+			usage.LastUsedTs = max(usage.LastUsedTs, createdTs)
+			monthlyCountMap[tag][month]++
+			for j := i + 1; j < end; j++ {
+				pair := [2]string{tag, memoTags[j].Tag}
+				if pair[0] > pair[1] {
+					pair[0], pair[1] = pair[1], pair[0]
+				}
+				if !isSubTag(pair[1], pair[0]) {
+					coOccurrenceMap[pair]++
+				}
+			}
+		}
+		start = end
+	}
+
+	stats := &TagStats{
+		Usages:        []*TagUsage{},
+		CoOccurrences: []*TagCoOccurrence{},
+	}
+	for tag, usage := range usageMap {
+		usage.MonthlyUsages = []*TagMonthlyUsage{}
+		for month, count := range monthlyCountMap[tag] {
+			usage.MonthlyUsages = append(usage.MonthlyUsages, &TagMonthlyUsage{Month: month, MemoCount: count})
+		}
+		sort.Slice(usage.MonthlyUsages, func(i, j int) bool {
+			return usage.MonthlyUsages[i].Month < usage.MonthlyUsages[j].Month
+		})
+		stats.Usages = append(stats.Usages, usage)
+	}
+	sort.Slice(stats.Usages, func(i, j int) bool {
+		return stats.Usages[i].Tag < stats.Usages[j].Tag
+	})
+	for pair, count := range coOccurrenceMap {
+		stats.CoOccurrences = append(stats.CoOccurrences, &TagCoOccurrence{Tag: pair[0], OtherTag: pair[1], MemoCount: count})
+	}
+	sortTagCoOccurrences(stats.CoOccurrences)
+
+	if find.RootTag != "" {
+		stats.Tree = buildTagTree(find.RootTag, usageMap)
+		stats.Neighbors = []*TagCoOccurrence{}
+		for _, coOccurrence := range stats.CoOccurrences {
+			if coOccurrence.Tag == find.RootTag {
+				stats.Neighbors = append(stats.Neighbors, coOccurrence)
+			} else if coOccurrence.OtherTag == find.RootTag {
+				stats.Neighbors = append(stats.Neighbors, &TagCoOccurrence{Tag: find.RootTag, OtherTag: coOccurrence.Tag, MemoCount: coOccurrence.MemoCount})
+			}
+		}
+		sortTagCoOccurrences(stats.Neighbors)
+	}
+	return stats, nil
+}
+
+// buildTagTree returns the tree of the root tag and its used sub tags, including the unused tags between them.
+func buildTagTree(rootTag string, usageMap map[string]*TagUsage) *TagTreeNode {
+	nodeMap := map[string]*TagTreeNode{
+		rootTag: {Tag: rootTag, Children: []*TagTreeNode{}},
+	}
+	var getNode func(tag string) *TagTreeNode
+	getNode = func(tag string) *TagTreeNode {
+		if node, ok := nodeMap[tag]; ok {
+			return node
+		}
+		node := &TagTreeNode{Tag: tag, Children: []*TagTreeNode{}}
+		nodeMap[tag] = node
+		parent := getNode(tag[:strings.LastIndex(tag, "/")])
+		parent.Children = append(parent.Children, node)
+		return node
+	}
+	for tag, usage := range usageMap {
+		if tag == rootTag || isSubTag(tag, rootTag) {
+			getNode(tag).MemoCount = usage.MemoCount
+		}
+	}
+	for _, node := range nodeMap {
+		sort.Slice(node.Children, func(i, j int) bool {
+			return node.Children[i].Tag < node.Children[j].Tag
+		})
+	}
+	return nodeMap[rootTag]
+}
+
+// isSubTag returns whether the tag is under the parent tag, e.g. `work/project` of `work`.
+func isSubTag(tag, parent string) bool {
+	return strings.HasPrefix(tag, parent+"/")
+}
+
+func sortTagCoOccurrences(coOccurrences []*TagCoOccurrence) {
+	sort.Slice(coOccurrences, func(i, j int) bool {
+		if coOccurrences[i].MemoCount != coOccurrences[j].MemoCount {
+			return coOccurrences[i].MemoCount > coOccurrences[j].MemoCount
+		}
+		if coOccurrences[i].Tag != coOccurrences[j].Tag {
+			return coOccurrences[i].Tag < coOccurrences[j].Tag
+		}
+		return coOccurrences[i].OtherTag < coOccurrences[j].OtherTag
+	})
+}
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/util"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// TelegramLinkCodeTTL is how long a telegram link code can be used after it is created.
+const TelegramLinkCodeTTL = 10 * time.Minute
+
+// ErrInvalidTelegramLinkCode is returned when a telegram link code is unknown, used or expired.
+var ErrInvalidTelegramLinkCode = errors.New("invalid or expired link code")
+
+type telegramLinkCode struct {
+	userID    int32
+	expiresAt time.Time
+}
+
+// CreateTelegramLinkCode creates a one-time code, which links the telegram account sending it to the bot to the user.
+// The codes are kept in memory, so a restart invalidates them. A user has one code at a time,
+// so the new code replaces the previous one, and the expired codes are swept meanwhile.
+func (s *Store) CreateTelegramLinkCode(userID int32) (string, time.Time, error) {
+	code, err := util.RandomString(8)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	code = strings.ToUpper(code)
+	now := time.Now()
+	s.telegramLinkCodes.Range(func(key, value any) bool {
+		if linkCode := value.(*telegramLinkCode); linkCode.userID == userID || now.After(linkCode.expiresAt) {
+			s.telegramLinkCodes.Delete(key)
+		}
+		return true
+	})
+	expiresAt := now.Add(TelegramLinkCodeTTL)
+	s.telegramLinkCodes.Store(code, &telegramLinkCode{
+		userID:    userID,
+		expiresAt: expiresAt,
+	})
+	return code, expiresAt, nil
+}
+
+// LinkTelegramUser consumes the link code and links the telegram user id to the user of the code.
+// The sender of the code owns the telegram account, so it is unlinked from any other user.
+func (s *Store) LinkTelegramUser(ctx context.Context, code, telegramUserID string) (int32, error) {
+	value, ok := s.telegramLinkCodes.LoadAndDelete(strings.ToUpper(strings.TrimSpace(code)))
+	if !ok {
+		return 0, ErrInvalidTelegramLinkCode
+	}
+	linkCode := value.(*telegramLinkCode)
+	if time.Now().After(linkCode.expiresAt) {
+		return 0, ErrInvalidTelegramLinkCode
+	}
+
+	userSettings, err := s.ListUserSettingsV1(ctx, &FindUserSetting{
+		TelegramUserID: &telegramUserID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, userSetting := range userSettings {
+		if userSetting.UserId == linkCode.userID {
+			continue
+		}
+		if _, err := s.UpsertUserSettingV1(ctx, &storepb.UserSetting{
+			UserId: userSetting.UserId,
+			Key:    storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID,
+			Value:  &storepb.UserSetting_TelegramUserId{TelegramUserId: ""},
+		}); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := s.UpsertUserSettingV1(ctx, &storepb.UserSetting{
+		UserId: linkCode.userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID,
+		Value:  &storepb.UserSetting_TelegramUserId{TelegramUserId: telegramUserID},
+	}); err != nil {
+		return 0, err
+	}
+	return linkCode.userID, nil
+}
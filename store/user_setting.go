@@ -9,6 +9,8 @@ import (
 type FindUserSetting struct {
 	UserID *int32
 	Key    storepb.UserSettingKey
+	// TelegramUserID finds the telegram user id setting with the value, using the index on it.
+	TelegramUserID *string
 }
 
 func (s *Store) UpsertUserSettingV1(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error) {
@@ -97,3 +99,21 @@ func (s *Store) RemoveUserAccessToken(ctx context.Context, userID int32, token s
 
 	return err
 }
+
+// GetUserIDByTelegramUserID returns the id of the user linked to the telegram user id, or 0 if there is none.
+func (s *Store) GetUserIDByTelegramUserID(ctx context.Context, telegramUserID string) (int32, error) {
+	if telegramUserID == "" {
+		return 0, nil
+	}
+
+	userSettings, err := s.ListUserSettingsV1(ctx, &FindUserSetting{
+		TelegramUserID: &telegramUserID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(userSettings) == 0 {
+		return 0, nil
+	}
+	return userSettings[0].UserId, nil
+}
@@ -16,6 +16,8 @@ type UpdateWebhook struct {
 	RowStatus *storepb.RowStatus
 	Name      *string
 	URL       *string
+	Filter    *storepb.WebhookFilter
+	Secret    *string
 }
 
 type DeleteWebhook struct {
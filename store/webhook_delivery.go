@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery attempt.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is the status of an attempt waiting to be sent.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded is the status of an attempt accepted by the receiver.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed is the status of an attempt rejected by or not sent to the receiver.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery is an attempt to post an event payload to a webhook.
+// A failed attempt is retried with a new delivery whose attempt number is increased.
+type WebhookDelivery struct {
+	ID int32
+
+	// Standard fields
+	CreatedTs int64
+	UpdatedTs int64
+
+	// Domain specific fields
+	WebhookID     int32
+	ActivityType  string
+	Payload       string
+	Attempt       int32
+	Status        WebhookDeliveryStatus
+	NextAttemptTs int64
+	StatusCode    int32
+	LatencyMs     int64
+	ResponseBody  string
+}
+
+type FindWebhookDelivery struct {
+	ID        *int32
+	WebhookID *int32
+	Status    *WebhookDeliveryStatus
+	// NextAttemptTsBefore finds the deliveries due before the given time.
+	NextAttemptTsBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type UpdateWebhookDelivery struct {
+	ID           int32
+	UpdatedTs    *int64
+	Status       *WebhookDeliveryStatus
+	StatusCode   *int32
+	LatencyMs    *int64
+	ResponseBody *string
+}
+
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.CreateWebhookDelivery(ctx, create)
+}
+
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	return s.driver.ListWebhookDeliveries(ctx, find)
+}
+
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDelivery) (*WebhookDelivery, error) {
+	list, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.UpdateWebhookDelivery(ctx, update)
+}
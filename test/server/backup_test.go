@@ -0,0 +1,209 @@
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/api/v1"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+)
+
+func TestBackupServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	signup := &apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	}
+	_, err = s.postAuthSignUp(signup)
+	require.NoError(t, err)
+
+	err = s.postSystemSetting(&apiv1.UpsertSystemSettingRequest{
+		Name:  apiv1.SystemSettingBackupConfigName,
+		Value: `{"enabled":true,"cron":"not a cron","maxKeep":2}`,
+	})
+	require.Error(t, err)
+	err = s.postSystemSetting(&apiv1.UpsertSystemSettingRequest{
+		Name:  apiv1.SystemSettingBackupConfigName,
+		Value: `{"enabled":true,"cron":"0 3 * * *","maxKeep":2}`,
+	})
+	require.NoError(t, err)
+
+	// Prepare the old backups to be pruned.
+	backupDir := filepath.Join(filepath.Dir(s.profile.DSN), "backups")
+	require.NoError(t, os.MkdirAll(backupDir, os.ModePerm))
+	for _, name := range []string{"backup-20200101000000000.db", "backup-20210101000000000.db"} {
+		require.NoError(t, os.WriteFile(filepath.Join(backupDir, name), []byte("old"), 0644))
+	}
+
+	backup, err := s.postBackupCreate()
+	require.NoError(t, err)
+	require.Greater(t, backup.Size, int64(0))
+	backupList, err := s.getBackupList()
+	require.NoError(t, err)
+	require.Len(t, backupList, 2)
+	require.Equal(t, backup.Name, backupList[0].Name)
+	require.Equal(t, "backup-20210101000000000.db", backupList[1].Name)
+
+	body, err := s.get(fmt.Sprintf("/api/v1/backup/%s", backup.Name), nil)
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, backup.Size, int64(len(data)))
+	_, err = s.get("/api/v1/backup/..%2Fmemos_dev.db", nil)
+	require.Error(t, err)
+
+	_, err = s.delete(fmt.Sprintf("/api/v1/backup/%s", backupList[1].Name), nil)
+	require.NoError(t, err)
+	backupList, err = s.getBackupList()
+	require.NoError(t, err)
+	require.Len(t, backupList, 1)
+}
+
+func TestBackupRestoreServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	signup := &apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	}
+	_, err = s.postAuthSignUp(signup)
+	require.NoError(t, err)
+	_, err = s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "before backup",
+	})
+	require.NoError(t, err)
+	backup, err := s.postBackupCreate()
+	require.NoError(t, err)
+	_, err = s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "after backup",
+	})
+	require.NoError(t, err)
+
+	// Invalid backups are rejected and leave the database untouched.
+	err = s.postBackupRestoreUpload([]byte("not a database"))
+	require.ErrorContains(t, err, "400")
+	memoList, err := s.getMemoList()
+	require.NoError(t, err)
+	require.Len(t, memoList, 2)
+
+	err = s.postBackupRestore(backup.Name)
+	require.NoError(t, err)
+	memoList, err = s.getMemoList()
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	require.Equal(t, "before backup", memoList[0].Content)
+	listMemosResponse := &apiv2pb.ListMemosResponse{}
+	err = s.callGRPCWeb("MemoService/ListMemos", &apiv2pb.ListMemosRequest{}, listMemosResponse)
+	require.NoError(t, err)
+	require.Len(t, listMemosResponse.Memos, 1)
+
+	// The database before the restore is backed up, so the restore can be reverted by uploading it.
+	backupList, err := s.getBackupList()
+	require.NoError(t, err)
+	require.Len(t, backupList, 2)
+	body, err := s.get(fmt.Sprintf("/api/v1/backup/%s", backupList[0].Name), nil)
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	err = s.postBackupRestoreUpload(data)
+	require.NoError(t, err)
+	memoList, err = s.getMemoList()
+	require.NoError(t, err)
+	require.Len(t, memoList, 2)
+}
+
+func (s *TestingServer) postSystemSetting(upsert *apiv1.UpsertSystemSettingRequest) error {
+	rawData, err := json.Marshal(upsert)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal system setting upsert")
+	}
+	_, err = s.post("/api/v1/system/setting", bytes.NewReader(rawData), nil)
+	return err
+}
+
+func (s *TestingServer) getBackupList() ([]*apiv1.Backup, error) {
+	body, err := s.get("/api/v1/backup", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	backupList := []*apiv1.Backup{}
+	if err = json.Unmarshal(buf.Bytes(), &backupList); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal get backup list response")
+	}
+	return backupList, nil
+}
+
+func (s *TestingServer) postBackupCreate() (*apiv1.Backup, error) {
+	body, err := s.post("/api/v1/backup", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	backup := &apiv1.Backup{}
+	if err = json.Unmarshal(buf.Bytes(), backup); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal post backup create response")
+	}
+	return backup, nil
+}
+
+func (s *TestingServer) postBackupRestore(name string) error {
+	form := url.Values{}
+	form.Set("name", name)
+	_, err := s.request("POST", "/api/v1/backup/restore", strings.NewReader(form.Encode()), nil, map[string]string{
+		"Cookie":       s.cookie,
+		"Content-Type": "application/x-www-form-urlencoded",
+	})
+	return err
+}
+
+func (s *TestingServer) postBackupRestoreUpload(data []byte) error {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+	part, err := writer.CreateFormFile("file", "backup.db")
+	if err != nil {
+		return errors.Wrap(err, "failed to create form file")
+	}
+	if _, err := part.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write form file")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to close multipart writer")
+	}
+	_, err = s.request("POST", "/api/v1/backup/restore", buf, nil, map[string]string{
+		"Cookie":       s.cookie,
+		"Content-Type": writer.FormDataContentType(),
+	})
+	return err
+}
@@ -0,0 +1,493 @@
+package testserver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/api/v1"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+)
+
+func TestExportImportServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	signup := &apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	}
+	_, err = s.postAuthSignUp(signup)
+	require.NoError(t, err)
+	resource, err := s.postResourceUpload("hello.txt", []byte("hello world"))
+	require.NoError(t, err)
+	memo, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content:        fmt.Sprintf("see [hello](/o/r/%d/hello.txt)", resource.ID),
+		Visibility:     apiv1.Public,
+		ResourceIDList: []int32{resource.ID},
+	})
+	require.NoError(t, err)
+	comment, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "---\nnot front matter\n---",
+		RelationList: []*apiv1.UpsertMemoRelationRequest{
+			{
+				RelatedMemoID: memo.ID,
+				Type:          apiv1.MemoRelationComment,
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = s.postMemoOrganizer(comment.ID, &apiv1.UpsertMemoOrganizerRequest{
+		Pinned: true,
+	})
+	require.NoError(t, err)
+
+	data, err := s.getExport()
+	require.NoError(t, err)
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	names := []string{}
+	for _, file := range zipReader.File {
+		names = append(names, file.Name)
+	}
+	require.ElementsMatch(t, []string{
+		fmt.Sprintf("memos/%d.md", memo.ID),
+		fmt.Sprintf("memos/%d.md", comment.ID),
+		fmt.Sprintf("resources/%d_hello.txt", resource.ID),
+		"manifest.json",
+	}, names)
+
+	// Importing the archive again creates copies with new IDs.
+	result, err := s.postImport(data)
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.ImportResult{
+		MemoCount:     2,
+		ResourceCount: 1,
+		RelationCount: 1,
+	}, result)
+	memoList, err := s.getMemoList()
+	require.NoError(t, err)
+	require.Len(t, memoList, 4)
+
+	var importedMemo, importedComment *apiv1.Memo
+	for _, item := range memoList {
+		if item.ID == memo.ID || item.ID == comment.ID {
+			continue
+		}
+		if item.Pinned {
+			importedComment = item
+		} else {
+			importedMemo = item
+		}
+	}
+	require.NotNil(t, importedMemo)
+	require.NotNil(t, importedComment)
+	require.Equal(t, comment.Content, importedComment.Content)
+	require.Equal(t, comment.CreatedTs, importedComment.CreatedTs)
+	require.Len(t, importedComment.RelationList, 1)
+	require.Equal(t, importedMemo.ID, importedComment.RelationList[0].RelatedMemoID)
+	require.Equal(t, apiv1.Public, importedMemo.Visibility)
+	require.Len(t, importedMemo.ResourceList, 1)
+	importedResource := importedMemo.ResourceList[0]
+	require.NotEqual(t, resource.ID, importedResource.ID)
+	require.Equal(t, fmt.Sprintf("see [hello](/o/r/%d/hello.txt)", importedResource.ID), importedMemo.Content)
+	body, err := s.get(fmt.Sprintf("/o/r/%d", importedResource.ID), nil)
+	require.NoError(t, err)
+	blob, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(blob))
+
+	// Invalid archives import nothing.
+	_, err = s.postImport([]byte("not a zip"))
+	require.Error(t, err)
+	memoList, err = s.getMemoList()
+	require.NoError(t, err)
+	require.Len(t, memoList, 4)
+}
+
+func TestExportImportMemoLinksServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	first, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "first"})
+	require.NoError(t, err)
+	second, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: fmt.Sprintf("second links [[memo:%d]]", first.ID)})
+	require.NoError(t, err)
+	// The first memo links to the second one, which is imported after it.
+	firstContent := fmt.Sprintf("first links [[memo:%d]]", second.ID)
+	_, err = s.patchMemo(&apiv1.PatchMemoRequest{ID: first.ID, Content: &firstContent})
+	require.NoError(t, err)
+
+	data, err := s.getExport()
+	require.NoError(t, err)
+	_, err = s.postImport(data)
+	require.NoError(t, err)
+
+	// The links of the imported memos are rewritten to the imported memos, with their relations.
+	memoList, err := s.getMemoList()
+	require.NoError(t, err)
+	require.Len(t, memoList, 4)
+	importedMemos := map[string]*apiv1.Memo{}
+	for _, memo := range memoList {
+		if memo.ID != first.ID && memo.ID != second.ID {
+			importedMemos[memo.Content[:strings.Index(memo.Content, " ")]] = memo
+		}
+	}
+	importedFirst, importedSecond := importedMemos["first"], importedMemos["second"]
+	require.NotNil(t, importedFirst)
+	require.NotNil(t, importedSecond)
+	require.Equal(t, fmt.Sprintf("first links [[memo:%d]]", importedSecond.ID), importedFirst.Content)
+	require.Equal(t, fmt.Sprintf("second links [[memo:%d]]", importedFirst.ID), importedSecond.Content)
+	for _, memo := range []*apiv1.Memo{importedFirst, importedSecond} {
+		relatedMemoIDs := []int32{}
+		for _, relation := range memo.RelationList {
+			if relation.MemoID == memo.ID {
+				relatedMemoIDs = append(relatedMemoIDs, relation.RelatedMemoID)
+			}
+		}
+		otherID := importedFirst.ID
+		if memo == importedFirst {
+			otherID = importedSecond.ID
+		}
+		require.Equal(t, []int32{otherID}, relatedMemoIDs)
+		// The rewrite of the links to the memos imported later is not a revision.
+		revisionList, err := s.getMemoRevisionList(memo.ID)
+		require.NoError(t, err)
+		require.Empty(t, revisionList)
+	}
+}
+
+func TestImportMemoLinksOutsideArchiveServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	otherMemo, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content:    "a public memo of another user",
+		Visibility: apiv1.Public,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.postSignOut())
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser2",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	// The archive links to a memo which is not in it, but whose ID is used by a memo here.
+	data := buildImportArchive(t, map[string][]byte{
+		"manifest.json": []byte(`{"version": 1}`),
+		"memos/100.md":  []byte(fmt.Sprintf("---\nid: 100\n---\nsee [[memo:%d]]", otherMemo.ID)),
+	})
+
+	result, err := s.postImport(data)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.MemoCount)
+	memoList, err := s.getMemoList()
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	require.Equal(t, fmt.Sprintf("see memo:%d", otherMemo.ID), memoList[0].Content)
+	require.Empty(t, memoList[0].RelationList)
+}
+
+func TestImportResourceLimitServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	err = s.postSystemSetting(&apiv1.UpsertSystemSettingRequest{
+		Name:  apiv1.SystemSettingMaxUploadSizeMiBName,
+		Value: "1",
+	})
+	require.NoError(t, err)
+
+	// The resources larger than the max upload size are rejected before anything is imported.
+	data := buildImportArchive(t, map[string][]byte{
+		"manifest.json":         []byte(`{"version": 1, "resources": [{"id": 1, "filename": "large.bin", "path": "resources/1_large.bin"}]}`),
+		"memos/1.md":            []byte("---\nid: 1\n---\nlarge"),
+		"resources/1_large.bin": bytes.Repeat([]byte{0}, 2<<20),
+	})
+	_, err = s.postImport(data)
+	require.ErrorContains(t, err, "400")
+	memoList, err := s.getMemoList()
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+}
+
+func TestImportRollbackServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	// The blobs can't be saved to a storage which doesn't exist.
+	err = s.postSystemSetting(&apiv1.UpsertSystemSettingRequest{
+		Name:  apiv1.SystemSettingStorageServiceIDName,
+		Value: "99",
+	})
+	require.NoError(t, err)
+
+	// The resources created before the failure are deleted.
+	data := buildImportArchive(t, map[string][]byte{
+		"manifest.json": []byte(`{"version": 1, "resources": [
+			{"id": 1, "filename": "link", "externalLink": "https://example.com/link"},
+			{"id": 2, "filename": "hello.txt", "path": "resources/2_hello.txt"}
+		]}`),
+		"memos/1.md":            []byte("---\nid: 1\n---\nhello"),
+		"resources/2_hello.txt": []byte("hello world"),
+	})
+	_, err = s.postImport(data)
+	require.ErrorContains(t, err, "500")
+	body, err := s.get("/api/v1/resource", nil)
+	require.NoError(t, err)
+	resourceList := []*apiv1.Resource{}
+	require.NoError(t, json.NewDecoder(body).Decode(&resourceList))
+	require.Empty(t, resourceList)
+	memoList, err := s.getMemoList()
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+}
+
+func TestExportImportRelationTypesServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postMemoRelationTypeDefinitionCreate(&apiv1.CreateMemoRelationTypeDefinitionRequest{
+		Name:        "supports",
+		InverseName: "supported-by",
+	})
+	require.NoError(t, err)
+	claim, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "claim"})
+	require.NoError(t, err)
+	_, err = s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "evidence",
+		RelationList: []*apiv1.UpsertMemoRelationRequest{
+			{
+				RelatedMemoID: claim.ID,
+				Type:          "supports",
+			},
+		},
+	})
+	require.NoError(t, err)
+	data, err := s.getExport()
+	require.NoError(t, err)
+	require.NoError(t, s.postSignOut())
+
+	// The custom relation types are created for the importing user with the relations using them.
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser2",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	result, err := s.postImport(data)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.MemoCount)
+	require.Equal(t, 1, result.RelationCount)
+	body, err := s.get("/api/v1/memo/relation-type", nil)
+	require.NoError(t, err)
+	definitionList := []*apiv1.MemoRelationTypeDefinition{}
+	require.NoError(t, json.NewDecoder(body).Decode(&definitionList))
+	require.Len(t, definitionList, 1)
+	require.Equal(t, "supports", definitionList[0].Name)
+	require.Equal(t, "supported-by", definitionList[0].InverseName)
+	memoList, err := s.getMemoList()
+	require.NoError(t, err)
+	require.Len(t, memoList, 2)
+	var importedClaim, importedEvidence *apiv1.Memo
+	for _, memo := range memoList {
+		if memo.Content == "claim" {
+			importedClaim = memo
+		} else {
+			importedEvidence = memo
+		}
+	}
+	require.NotNil(t, importedClaim)
+	require.Len(t, importedEvidence.RelationList, 1)
+	require.Equal(t, importedClaim.ID, importedEvidence.RelationList[0].RelatedMemoID)
+	require.Equal(t, apiv1.MemoRelationType("supports"), importedEvidence.RelationList[0].Type)
+}
+
+func TestExportImportTagsServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "#work meeting"})
+	require.NoError(t, err)
+	err = s.callGRPCWeb("TagService/UpsertTag", &apiv2pb.UpsertTagRequest{
+		Name: "work",
+		Tag: &apiv2pb.Tag{
+			Color:       "#16a34a",
+			Icon:        "briefcase",
+			Description: "Things to do at work",
+			SortWeight:  10,
+			Favorite:    true,
+			Aliases:     []string{"job", "office"},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"color", "icon", "description", "sort_weight", "favorite", "aliases"}},
+	}, &apiv2pb.UpsertTagResponse{})
+	require.NoError(t, err)
+	data, err := s.getExport()
+	require.NoError(t, err)
+	require.NoError(t, s.postSignOut())
+
+	// The tags are imported with their metadata, except the aliases used by the other tags.
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser2",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	err = s.callGRPCWeb("TagService/UpsertTag", &apiv2pb.UpsertTagRequest{
+		Name:       "career",
+		Tag:        &apiv2pb.Tag{Aliases: []string{"job"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"aliases"}},
+	}, &apiv2pb.UpsertTagResponse{})
+	require.NoError(t, err)
+	result, err := s.postImport(data)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.TagCount)
+
+	response := &apiv2pb.ListTagsResponse{}
+	err = s.callGRPCWeb("TagService/ListTags", &apiv2pb.ListTagsRequest{User: "users/testuser2"}, response)
+	require.NoError(t, err)
+	tags := map[string]*apiv2pb.Tag{}
+	for _, tag := range response.Tags {
+		tags[tag.Name] = tag
+	}
+	require.Contains(t, tags, "work")
+	work := tags["work"]
+	require.Equal(t, "#16a34a", work.Color)
+	require.Equal(t, "briefcase", work.Icon)
+	require.Equal(t, "Things to do at work", work.Description)
+	require.Equal(t, int32(10), work.SortWeight)
+	require.True(t, work.Favorite)
+	require.Equal(t, []string{"office"}, work.Aliases)
+	require.Equal(t, []string{"job"}, tags["career"].Aliases)
+}
+
+func buildImportArchive(t *testing.T, files map[string][]byte) []byte {
+	buf := &bytes.Buffer{}
+	zipWriter := zip.NewWriter(buf)
+	for name, data := range files {
+		writer, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+	return buf.Bytes()
+}
+
+func (s *TestingServer) getExport() ([]byte, error) {
+	body, err := s.get("/api/v1/export", nil)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(body)
+}
+
+func (s *TestingServer) postImport(data []byte) (*apiv1.ImportResult, error) {
+	body, err := s.postMultipartFile("/api/v1/import", "export.zip", data)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	result := &apiv1.ImportResult{}
+	if err = json.Unmarshal(buf.Bytes(), result); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal post import response")
+	}
+	return result, nil
+}
+
+func (s *TestingServer) postResourceUpload(filename string, data []byte) (*apiv1.Resource, error) {
+	body, err := s.postMultipartFile("/api/v1/resource/blob", filename, data)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	resource := &apiv1.Resource{}
+	if err = json.Unmarshal(buf.Bytes(), resource); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal post resource upload response")
+	}
+	return resource, nil
+}
+
+func (s *TestingServer) postMultipartFile(uri, filename string, data []byte) (io.ReadCloser, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create form file")
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, errors.Wrap(err, "failed to write form file")
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close multipart writer")
+	}
+	return s.request("POST", uri, buf, nil, map[string]string{
+		"Cookie":       s.cookie,
+		"Content-Type": writer.FormDataContentType(),
+	})
+}
@@ -0,0 +1,198 @@
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/usememos/memos/api/v1"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+)
+
+func TestMemoGraphServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	root, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "root", Visibility: apiv1.Public})
+	require.NoError(t, err)
+	private, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content:      "private",
+		Visibility:   apiv1.Private,
+		RelationList: []*apiv1.UpsertMemoRelationRequest{{RelatedMemoID: root.ID, Type: apiv1.MemoRelationReference}},
+	})
+	require.NoError(t, err)
+	// The memo is only reachable through the private memo.
+	_, err = s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content:      "behind private",
+		Visibility:   apiv1.Public,
+		RelationList: []*apiv1.UpsertMemoRelationRequest{{RelatedMemoID: private.ID, Type: apiv1.MemoRelationReference}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.postSignOut())
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser2",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	other, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content:      "other",
+		Visibility:   apiv1.Protected,
+		RelationList: []*apiv1.UpsertMemoRelationRequest{{RelatedMemoID: root.ID, Type: apiv1.MemoRelationReference}},
+	})
+	require.NoError(t, err)
+
+	graph := &apiv2pb.GetMemoGraphResponse{}
+	require.NoError(t, s.callGRPCWeb("MemoRelationService/GetMemoGraph", &apiv2pb.GetMemoGraphRequest{Id: root.ID, Depth: 3}, graph))
+	require.Len(t, graph.Nodes, 2)
+	require.Equal(t, root.ID, graph.Nodes[0].Memo.Id)
+	require.Equal(t, []int32{other.ID}, graph.Nodes[0].BacklinkIds)
+	require.Equal(t, other.ID, graph.Nodes[1].Memo.Id)
+	require.Equal(t, int32(1), graph.Nodes[1].Depth)
+	require.Len(t, graph.Edges, 1)
+
+	// The outgoing direction does not follow the backlinks.
+	graph = &apiv2pb.GetMemoGraphResponse{}
+	require.NoError(t, s.callGRPCWeb("MemoRelationService/GetMemoGraph", &apiv2pb.GetMemoGraphRequest{Id: root.ID, Direction: apiv2pb.GetMemoGraphRequest_OUTGOING}, graph))
+	require.Len(t, graph.Nodes, 1)
+
+	backlinks := &apiv2pb.ListMemoBacklinksResponse{}
+	require.NoError(t, s.callGRPCWeb("MemoRelationService/ListMemoBacklinks", &apiv2pb.ListMemoBacklinksRequest{Id: root.ID}, backlinks))
+	require.Len(t, backlinks.Memos, 1)
+	require.Equal(t, other.ID, backlinks.Memos[0].Id)
+	require.Error(t, s.callGRPCWeb("MemoRelationService/GetMemoGraph", &apiv2pb.GetMemoGraphRequest{Id: private.ID}, &apiv2pb.GetMemoGraphResponse{}))
+
+	// The export only has the memos of the current user, without the relations to other users.
+	body, err := s.get("/api/v1/memo/graph", nil)
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	memoGraph := &apiv1.MemoGraph{}
+	require.NoError(t, json.Unmarshal(data, memoGraph))
+	require.Len(t, memoGraph.Nodes, 1)
+	require.Equal(t, other.ID, memoGraph.Nodes[0].ID)
+	require.Empty(t, memoGraph.Edges)
+	body, err = s.get("/api/v1/memo/graph", map[string]string{"format": "graphml"})
+	require.NoError(t, err)
+	data, err = io.ReadAll(body)
+	require.NoError(t, err)
+	require.Contains(t, string(data), fmt.Sprintf(`<node id="memo-%d">`, other.ID))
+
+	// The anonymous users only see the public memos.
+	require.NoError(t, s.postSignOut())
+	graph = &apiv2pb.GetMemoGraphResponse{}
+	require.NoError(t, s.callGRPCWeb("MemoRelationService/GetMemoGraph", &apiv2pb.GetMemoGraphRequest{Id: root.ID, Depth: 5}, graph))
+	require.Len(t, graph.Nodes, 1)
+	require.Empty(t, graph.Edges)
+}
+
+func TestMemoGraphCustomTypesServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postMemoRelationTypeDefinitionCreate(&apiv1.CreateMemoRelationTypeDefinitionRequest{
+		Name:        "supports",
+		InverseName: "supported-by",
+	})
+	require.NoError(t, err)
+	claim, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "claim"})
+	require.NoError(t, err)
+	evidence, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "evidence"})
+	require.NoError(t, err)
+	_, err = s.postMemoRelationUpsert(evidence.ID, &apiv1.UpsertMemoRelationRequest{
+		RelatedMemoID: claim.ID,
+		Type:          "supports",
+	})
+	require.NoError(t, err)
+	_, err = s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content:      "reference",
+		RelationList: []*apiv1.UpsertMemoRelationRequest{{RelatedMemoID: claim.ID, Type: apiv1.MemoRelationReference}},
+	})
+	require.NoError(t, err)
+
+	// Only the relations of the custom type are traversed.
+	graph := &apiv2pb.GetMemoGraphResponse{}
+	require.NoError(t, s.callGRPCWeb("MemoRelationService/GetMemoGraph", &apiv2pb.GetMemoGraphRequest{Id: claim.ID, CustomTypes: []string{"supports"}}, graph))
+	require.Len(t, graph.Nodes, 2)
+	require.Equal(t, evidence.ID, graph.Nodes[1].Memo.Id)
+	require.Len(t, graph.Edges, 1)
+	require.Equal(t, "supports", graph.Edges[0].CustomType)
+
+	// The built-in and custom types are combined.
+	graph = &apiv2pb.GetMemoGraphResponse{}
+	require.NoError(t, s.callGRPCWeb("MemoRelationService/GetMemoGraph", &apiv2pb.GetMemoGraphRequest{
+		Id:          claim.ID,
+		Types:       []apiv2pb.MemoRelation_Type{apiv2pb.MemoRelation_REFERENCE},
+		CustomTypes: []string{"supports"},
+	}, graph))
+	require.Len(t, graph.Nodes, 3)
+	require.Len(t, graph.Edges, 2)
+}
+
+// callGRPCWeb calls the unary method of the API v2 through the gRPC-Web proxy, with the cookie of the session.
+func (s *TestingServer) callGRPCWeb(method string, request, response proto.Message) error {
+	message, err := proto.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request")
+	}
+	frame := make([]byte, 5, 5+len(message))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message)))
+	body, err := s.request("POST", "/memos.api.v2."+method, bytes.NewReader(append(frame, message...)), nil, map[string]string{
+		"Content-Type": "application/grpc-web+proto",
+		"X-Grpc-Web":   "1",
+		"Cookie":       s.cookie,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response body")
+	}
+
+	// The response is a data frame followed by a trailer frame, while the status of the errors is only in the headers.
+	received := false
+	for len(data) >= 5 {
+		size := int(binary.BigEndian.Uint32(data[1:5]))
+		if len(data) < 5+size {
+			return errors.New("truncated gRPC-Web frame")
+		}
+		payload := data[5 : 5+size]
+		if data[0]&0x80 == 0 {
+			if err := proto.Unmarshal(payload, response); err != nil {
+				return errors.Wrap(err, "failed to unmarshal response")
+			}
+			received = true
+		} else if !strings.Contains(string(payload), "grpc-status: 0\r\n") {
+			return errors.Errorf("gRPC-Web error %q", string(payload))
+		}
+		data = data[5+size:]
+	}
+	if !received {
+		return errors.New("no message in gRPC-Web response")
+	}
+	return nil
+}
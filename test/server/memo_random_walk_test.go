@@ -0,0 +1,87 @@
+package testserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/api/v1"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+)
+
+func TestMemoRandomWalkServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser2",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	other, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "other #reading", Visibility: apiv1.Public})
+	require.NoError(t, err)
+	require.NoError(t, s.postSignOut())
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	memoIDs := map[int32]bool{}
+	start, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "start #reading"})
+	require.NoError(t, err)
+	memoIDs[start.ID] = true
+	for _, content := range []string{"second #reading", "third", "fourth #travel", "fifth #travel"} {
+		memo, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: content})
+		require.NoError(t, err)
+		memoIDs[memo.ID] = true
+	}
+	referencing, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content:      "referencing",
+		RelationList: []*apiv1.UpsertMemoRelationRequest{{RelatedMemoID: start.ID, Type: apiv1.MemoRelationReference}},
+	})
+	require.NoError(t, err)
+	memoIDs[referencing.ID] = true
+
+	response := &apiv2pb.RandomWalkResponse{}
+	err = s.callGRPCWeb("MemoRelationService/RandomWalk", &apiv2pb.RandomWalkRequest{Id: start.ID, Steps: 3}, response)
+	require.NoError(t, err)
+	require.NotEmpty(t, response.Session)
+	require.Len(t, response.Path, 3)
+	require.Equal(t, start.ID, response.Path[0].Memo.Id)
+	require.Equal(t, apiv2pb.RandomWalkStep_START, response.Path[0].Reason)
+	visited := map[int32]bool{}
+	for _, step := range response.Path {
+		require.True(t, memoIDs[step.Memo.Id])
+		require.NotEqual(t, other.ID, step.Memo.Id)
+		require.False(t, visited[step.Memo.Id])
+		visited[step.Memo.Id] = true
+		require.Greater(t, step.Probability, 0.0)
+		if step.Reason == apiv2pb.RandomWalkStep_SHARED_TAG {
+			require.NotEmpty(t, step.Tag)
+		}
+	}
+
+	// The session avoids the memos of the previous walk while there are others.
+	next := &apiv2pb.RandomWalkResponse{}
+	err = s.callGRPCWeb("MemoRelationService/RandomWalk", &apiv2pb.RandomWalkRequest{Steps: 3, Session: response.Session}, next)
+	require.NoError(t, err)
+	require.Equal(t, response.Session, next.Session)
+	require.Len(t, next.Path, 3)
+	for _, step := range next.Path {
+		require.True(t, memoIDs[step.Memo.Id])
+		require.False(t, visited[step.Memo.Id])
+		visited[step.Memo.Id] = true
+	}
+
+	err = s.callGRPCWeb("MemoRelationService/RandomWalk", &apiv2pb.RandomWalkRequest{Id: other.ID}, &apiv2pb.RandomWalkResponse{})
+	require.Error(t, err)
+	err = s.callGRPCWeb("MemoRelationService/RandomWalk", &apiv2pb.RandomWalkRequest{Steps: 100}, &apiv2pb.RandomWalkResponse{})
+	require.Error(t, err)
+	require.NoError(t, s.postSignOut())
+	err = s.callGRPCWeb("MemoRelationService/RandomWalk", &apiv2pb.RandomWalkRequest{}, &apiv2pb.RandomWalkResponse{})
+	require.Error(t, err)
+}
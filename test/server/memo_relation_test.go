@@ -62,6 +62,119 @@ func TestMemoRelationServer(t *testing.T) {
 	require.Len(t, memo2.RelationList, 1)
 }
 
+func TestMemoWikiLinkServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	memo, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "# Reading list",
+	})
+	require.NoError(t, err)
+	memo2, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "See [[Reading list]] and [[Missing]]",
+	})
+	require.NoError(t, err)
+	require.Len(t, memo2.RelationList, 1)
+	require.Equal(t, memo.ID, memo2.RelationList[0].RelatedMemoID)
+	require.Equal(t, []string{"Missing"}, memo2.UnresolvedLinkList)
+
+	// The relations of the removed links are deleted on the next save, even without a relation list.
+	content := "No links anymore"
+	memo2, err = s.patchMemo(&apiv1.PatchMemoRequest{
+		ID:      memo2.ID,
+		Content: &content,
+	})
+	require.NoError(t, err)
+	require.Len(t, memo2.RelationList, 0)
+	require.Empty(t, memo2.UnresolvedLinkList)
+}
+
+func TestMemoRelationTypeServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	claim, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "claim",
+	})
+	require.NoError(t, err)
+	evidence, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "evidence",
+	})
+	require.NoError(t, err)
+
+	// The relations of the undefined types are rejected.
+	_, err = s.postMemoRelationUpsert(evidence.ID, &apiv1.UpsertMemoRelationRequest{
+		RelatedMemoID: claim.ID,
+		Type:          "supports",
+	})
+	require.ErrorContains(t, err, "400")
+	definition, err := s.postMemoRelationTypeDefinitionCreate(&apiv1.CreateMemoRelationTypeDefinitionRequest{
+		Name:        "supports",
+		InverseName: "supported-by",
+	})
+	require.NoError(t, err)
+	require.True(t, definition.Directed)
+	_, err = s.postMemoRelationTypeDefinitionCreate(&apiv1.CreateMemoRelationTypeDefinitionRequest{
+		Name: "supports",
+	})
+	require.ErrorContains(t, err, "409")
+
+	_, err = s.postMemoRelationUpsert(evidence.ID, &apiv1.UpsertMemoRelationRequest{
+		RelatedMemoID: claim.ID,
+		Type:          "supports",
+	})
+	require.NoError(t, err)
+	_, err = s.postMemoRelationUpsert(evidence.ID, &apiv1.UpsertMemoRelationRequest{
+		RelatedMemoID: claim.ID,
+		Type:          apiv1.MemoRelationReference,
+	})
+	require.NoError(t, err)
+	body, err := s.get(fmt.Sprintf("/api/v1/memo/%d/relation", evidence.ID), map[string]string{"type": "supports"})
+	require.NoError(t, err)
+	memoRelationList := []*apiv1.MemoRelation{}
+	require.NoError(t, json.NewDecoder(body).Decode(&memoRelationList))
+	require.Len(t, memoRelationList, 1)
+	require.Equal(t, apiv1.MemoRelationType("supports"), memoRelationList[0].Type)
+
+	_, err = s.delete(fmt.Sprintf("/api/v1/memo/relation-type/%d", definition.ID), nil)
+	require.NoError(t, err)
+	evidence, err = s.getMemo(evidence.ID)
+	require.NoError(t, err)
+	require.Len(t, evidence.RelationList, 1)
+	require.Equal(t, apiv1.MemoRelationReference, evidence.RelationList[0].Type)
+}
+
+func (s *TestingServer) postMemoRelationTypeDefinitionCreate(request *apiv1.CreateMemoRelationTypeDefinitionRequest) (*apiv1.MemoRelationTypeDefinition, error) {
+	rawData, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal memo relation type create")
+	}
+	body, err := s.post("/api/v1/memo/relation-type", bytes.NewReader(rawData), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	definition := &apiv1.MemoRelationTypeDefinition{}
+	if err := json.NewDecoder(body).Decode(definition); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal post memo relation type response")
+	}
+	return definition, nil
+}
+
 func (s *TestingServer) postMemoRelationUpsert(memoID int32, memoRelationUpsert *apiv1.UpsertMemoRelationRequest) (*apiv1.MemoRelation, error) {
 	rawData, err := json.Marshal(&memoRelationUpsert)
 	if err != nil {
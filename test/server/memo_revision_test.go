@@ -0,0 +1,172 @@
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/api/v1"
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webhook"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+)
+
+func TestMemoRevisionServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	signup := &apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	}
+	user, err := s.postAuthSignUp(signup)
+	require.NoError(t, err)
+	require.Equal(t, signup.Username, user.Username)
+	memo, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "line 1\nline 2",
+	})
+	require.NoError(t, err)
+	updatedContent := "line 1\nline 3"
+	memo, err = s.patchMemo(&apiv1.PatchMemoRequest{
+		ID:      memo.ID,
+		Content: &updatedContent,
+	})
+	require.NoError(t, err)
+	require.Equal(t, updatedContent, memo.Content)
+
+	memoRevisionList, err := s.getMemoRevisionList(memo.ID)
+	require.NoError(t, err)
+	require.Len(t, memoRevisionList, 1)
+	require.Equal(t, "line 1\nline 2", memoRevisionList[0].Content)
+
+	diff, err := s.getMemoRevisionDiff(memo.ID, memoRevisionList[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, []util.DiffLine{
+		{Operation: util.DiffEqual, Content: "line 1"},
+		{Operation: util.DiffDelete, Content: "line 2"},
+		{Operation: util.DiffInsert, Content: "line 3"},
+	}, diff.LineList)
+
+	memo, err = s.postMemoRevisionRestore(memo.ID, memoRevisionList[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, "line 1\nline 2", memo.Content)
+	memoRevisionList, err = s.getMemoRevisionList(memo.ID)
+	require.NoError(t, err)
+	require.Len(t, memoRevisionList, 2)
+	require.Equal(t, updatedContent, memoRevisionList[0].Content)
+}
+
+func TestMemoRevisionRestoreWebhookServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	createWebhookResponse := &apiv2pb.CreateWebhookResponse{}
+	err = s.callGRPCWeb("WebhookService/CreateWebhook", &apiv2pb.CreateWebhookRequest{Name: "test", Url: "http://localhost:8081/hook"}, createWebhookResponse)
+	require.NoError(t, err)
+	memo, err := s.postMemoCreate(&apiv1.CreateMemoRequest{
+		Content: "first version",
+	})
+	require.NoError(t, err)
+	updatedContent := "second version"
+	memo, err = s.patchMemo(&apiv1.PatchMemoRequest{
+		ID:      memo.ID,
+		Content: &updatedContent,
+	})
+	require.NoError(t, err)
+	memoRevisionList, err := s.getMemoRevisionList(memo.ID)
+	require.NoError(t, err)
+	require.Len(t, memoRevisionList, 1)
+
+	// Restoring a revision with the v2 API dispatches the memo updated webhook as the v1 API does.
+	restoreResponse := &apiv2pb.RestoreMemoRevisionResponse{}
+	err = s.callGRPCWeb("MemoService/RestoreMemoRevision", &apiv2pb.RestoreMemoRevisionRequest{
+		Id:         memo.ID,
+		RevisionId: memoRevisionList[0].ID,
+	}, restoreResponse)
+	require.NoError(t, err)
+	require.Equal(t, "first version", restoreResponse.Memo.Content)
+	listDeliveriesResponse := &apiv2pb.ListWebhookDeliveriesResponse{}
+	err = s.callGRPCWeb("WebhookService/ListWebhookDeliveries", &apiv2pb.ListWebhookDeliveriesRequest{WebhookId: createWebhookResponse.Webhook.Id}, listDeliveriesResponse)
+	require.NoError(t, err)
+	restoredPayloads := []*webhook.WebhookPayload{}
+	for _, delivery := range listDeliveriesResponse.Deliveries {
+		payload := &webhook.WebhookPayload{}
+		require.NoError(t, json.Unmarshal([]byte(delivery.Payload), payload))
+		if delivery.Attempt == 1 && payload.ActivityType == "memos.memo.updated" && payload.Memo.Content == "first version" {
+			restoredPayloads = append(restoredPayloads, payload)
+		}
+	}
+	require.Len(t, restoredPayloads, 1)
+	require.Equal(t, memo.ID, restoredPayloads[0].Memo.ID)
+}
+
+func (s *TestingServer) getMemoRevisionList(memoID int32) ([]*apiv1.MemoRevision, error) {
+	body, err := s.get(fmt.Sprintf("/api/v1/memo/%d/revision", memoID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	memoRevisionList := []*apiv1.MemoRevision{}
+	if err = json.Unmarshal(buf.Bytes(), &memoRevisionList); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal get memo revision list response")
+	}
+	return memoRevisionList, nil
+}
+
+func (s *TestingServer) getMemoRevisionDiff(memoID, revisionID int32) (*apiv1.MemoRevisionDiff, error) {
+	body, err := s.get(fmt.Sprintf("/api/v1/memo/%d/revision/%d/diff", memoID, revisionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	diff := &apiv1.MemoRevisionDiff{}
+	if err = json.Unmarshal(buf.Bytes(), diff); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal get memo revision diff response")
+	}
+	return diff, nil
+}
+
+func (s *TestingServer) postMemoRevisionRestore(memoID, revisionID int32) (*apiv1.Memo, error) {
+	body, err := s.post(fmt.Sprintf("/api/v1/memo/%d/revision/%d/restore", memoID, revisionID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	memo := &apiv1.Memo{}
+	if err = json.Unmarshal(buf.Bytes(), memo); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal post memo revision restore response")
+	}
+	return memo, nil
+}
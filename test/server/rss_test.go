@@ -0,0 +1,50 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestUserViewRSSServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	signup := &apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	}
+	user, err := s.postAuthSignUp(signup)
+	require.NoError(t, err)
+	for _, create := range []*apiv1.CreateMemoRequest{
+		{Content: "public #work memo", Visibility: apiv1.Public},
+		{Content: "private #work memo", Visibility: apiv1.Private},
+		{Content: "public #life memo", Visibility: apiv1.Public},
+	} {
+		_, err = s.postMemoCreate(create)
+		require.NoError(t, err)
+	}
+	_, err = s.server.Store.CreateSavedSearch(ctx, &storepb.SavedSearch{
+		CreatorId: user.ID,
+		Name:      "work",
+		Filter:    `tag == "work"`,
+	})
+	require.NoError(t, err)
+
+	// Only the public memos of the view are in the feed.
+	body, err := s.get(fmt.Sprintf("/u/%d/view/work/rss.xml", user.ID), nil)
+	require.NoError(t, err)
+	rss, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Contains(t, string(rss), "public #work memo")
+	require.NotContains(t, string(rss), "private #work memo")
+	require.NotContains(t, string(rss), "public #life memo")
+}
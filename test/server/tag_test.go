@@ -0,0 +1,48 @@
+package testserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/api/v1"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+)
+
+func TestRenameTagServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	memo, err := s.postMemoCreate(&apiv1.CreateMemoRequest{Content: "#work meeting"})
+	require.NoError(t, err)
+
+	// An empty name is rejected instead of removing the tag from the memos.
+	err = s.callGRPCWeb("TagService/RenameTag", &apiv2pb.RenameTagRequest{
+		User:    "users/testuser",
+		OldName: "work",
+	}, &apiv2pb.RenameTagResponse{})
+	require.Error(t, err)
+	memo, err = s.getMemo(memo.ID)
+	require.NoError(t, err)
+	require.Equal(t, "#work meeting", memo.Content)
+
+	response := &apiv2pb.RenameTagResponse{}
+	err = s.callGRPCWeb("TagService/RenameTag", &apiv2pb.RenameTagRequest{
+		User:    "users/testuser",
+		OldName: "work",
+		NewName: "job",
+	}, response)
+	require.NoError(t, err)
+	require.Equal(t, []int32{memo.ID}, response.MemoIds)
+	memo, err = s.getMemo(memo.ID)
+	require.NoError(t, err)
+	require.Equal(t, "#job meeting", memo.Content)
+}
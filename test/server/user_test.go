@@ -6,11 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	apiv1 "github.com/usememos/memos/api/v1"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 )
 
 func TestUserServer(t *testing.T) {
@@ -102,3 +105,38 @@ func (s *TestingServer) patchUser(userID int32, request *apiv1.UpdateUserRequest
 	}
 	return user, nil
 }
+
+func TestUserTelegramLinkCodeServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	response := &apiv2pb.CreateTelegramLinkCodeResponse{}
+	err = s.callGRPCWeb("UserService/CreateTelegramLinkCode", &apiv2pb.CreateTelegramLinkCodeRequest{Name: "users/testuser"}, response)
+	require.NoError(t, err)
+	require.NotEmpty(t, response.Code)
+	require.True(t, response.ExpiresAt.AsTime().After(time.Now()))
+	err = s.callGRPCWeb("UserService/CreateTelegramLinkCode", &apiv2pb.CreateTelegramLinkCodeRequest{Name: "users/other"}, &apiv2pb.CreateTelegramLinkCodeResponse{})
+	require.Error(t, err)
+
+	// The telegram accounts can not be linked without the code.
+	err = s.callGRPCWeb("UserService/UpdateUserSetting", &apiv2pb.UpdateUserSettingRequest{
+		Setting:    &apiv2pb.UserSetting{Name: "users/testuser", TelegramUserId: "123456789"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"telegram_user_id"}},
+	}, &apiv2pb.UpdateUserSettingResponse{})
+	require.Error(t, err)
+
+	// Unlinking is allowed.
+	err = s.callGRPCWeb("UserService/UpdateUserSetting", &apiv2pb.UpdateUserSettingRequest{
+		Setting:    &apiv2pb.UserSetting{Name: "users/testuser"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"telegram_user_id"}},
+	}, &apiv2pb.UpdateUserSettingResponse{})
+	require.NoError(t, err)
+}
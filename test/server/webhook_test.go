@@ -0,0 +1,66 @@
+package testserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/api/v1"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+)
+
+func TestWebhookSecretServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUp{
+		Username: "testuser",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	// The generated secret is returned on creation only.
+	createResponse := &apiv2pb.CreateWebhookResponse{}
+	err = s.callGRPCWeb("WebhookService/CreateWebhook", &apiv2pb.CreateWebhookRequest{Name: "test", Url: "http://localhost:8081/hook"}, createResponse)
+	require.NoError(t, err)
+	webhook := createResponse.Webhook
+	require.NotEmpty(t, webhook.Secret)
+	require.True(t, webhook.HasSecret)
+
+	getResponse := &apiv2pb.GetWebhookResponse{}
+	err = s.callGRPCWeb("WebhookService/GetWebhook", &apiv2pb.GetWebhookRequest{Id: webhook.Id}, getResponse)
+	require.NoError(t, err)
+	require.Empty(t, getResponse.Webhook.Secret)
+	require.True(t, getResponse.Webhook.HasSecret)
+
+	listResponse := &apiv2pb.ListWebhooksResponse{}
+	err = s.callGRPCWeb("WebhookService/ListWebhooks", &apiv2pb.ListWebhooksRequest{}, listResponse)
+	require.NoError(t, err)
+	require.Len(t, listResponse.Webhooks, 1)
+	require.Empty(t, listResponse.Webhooks[0].Secret)
+	require.True(t, listResponse.Webhooks[0].HasSecret)
+
+	// Rotating the secret returns the new one, and the other updates do not.
+	updateResponse := &apiv2pb.UpdateWebhookResponse{}
+	err = s.callGRPCWeb("WebhookService/UpdateWebhook", &apiv2pb.UpdateWebhookRequest{
+		Webhook:    &apiv2pb.Webhook{Id: webhook.Id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"secret"}},
+	}, updateResponse)
+	require.NoError(t, err)
+	require.NotEmpty(t, updateResponse.Webhook.Secret)
+	require.NotEqual(t, webhook.Secret, updateResponse.Webhook.Secret)
+
+	updateResponse = &apiv2pb.UpdateWebhookResponse{}
+	err = s.callGRPCWeb("WebhookService/UpdateWebhook", &apiv2pb.UpdateWebhookRequest{
+		Webhook:    &apiv2pb.Webhook{Id: webhook.Id, Name: "renamed"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	}, updateResponse)
+	require.NoError(t, err)
+	require.Equal(t, "renamed", updateResponse.Webhook.Name)
+	require.Empty(t, updateResponse.Webhook.Secret)
+	require.True(t, updateResponse.Webhook.HasSecret)
+}
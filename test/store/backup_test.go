@@ -0,0 +1,108 @@
+package teststore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/server/service/backup"
+	"github.com/usememos/memos/store"
+)
+
+func TestBackupRunnerMigrateLegacyConfig(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	runner := backup.NewBackupRunner(ts)
+
+	_, err := ts.UpsertSystemSetting(ctx, &store.SystemSetting{
+		Name:  "auto-backup-interval",
+		Value: "7200",
+	})
+	require.NoError(t, err)
+	// The rotated backups of the interval setting, with the same modification time.
+	modTime := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, suffix := range []string{".bak", ".bak.1", ".bak.2"} {
+		require.NoError(t, os.WriteFile(ts.Profile.DSN+suffix, []byte("legacy"), 0644))
+		require.NoError(t, os.Chtimes(ts.Profile.DSN+suffix, modTime, modTime))
+	}
+	require.NoError(t, os.WriteFile(ts.Profile.DSN+".backup", []byte("other"), 0644))
+
+	require.NoError(t, runner.MigrateLegacyConfig(ctx))
+	backupConfig, err := backup.GetBackupConfig(ctx, ts)
+	require.NoError(t, err)
+	require.True(t, backupConfig.Enabled)
+	require.Equal(t, "0 */2 * * *", backupConfig.Cron)
+
+	backups, err := runner.ListBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, 3)
+	for _, backup := range backups {
+		require.Equal(t, modTime.Unix(), backup.CreatedTs)
+	}
+	for _, suffix := range []string{".bak", ".bak.1", ".bak.2"} {
+		_, err := os.Stat(ts.Profile.DSN + suffix)
+		require.True(t, os.IsNotExist(err))
+	}
+	_, err = os.Stat(ts.Profile.DSN + ".backup")
+	require.NoError(t, err)
+
+	// The backup config is not replaced once it is set.
+	_, err = ts.UpsertSystemSetting(ctx, &store.SystemSetting{
+		Name:  "auto-backup-interval",
+		Value: "60",
+	})
+	require.NoError(t, err)
+	require.NoError(t, runner.MigrateLegacyConfig(ctx))
+	backupConfig, err = backup.GetBackupConfig(ctx, ts)
+	require.NoError(t, err)
+	require.Equal(t, "0 */2 * * *", backupConfig.Cron)
+}
+
+func TestBackupRunnerRestoreInvalidBackup(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	runner := backup.NewBackupRunner(ts)
+
+	filename := filepath.Join(t.TempDir(), "invalid.db")
+	require.NoError(t, os.WriteFile(filename, []byte("not a database"), 0644))
+	require.ErrorIs(t, runner.RestoreBackup(ctx, filename), backup.ErrInvalidBackup)
+
+	// The failures other than the validation of the backup are not reported as invalid backups.
+	err := runner.RestoreBackup(ctx, filepath.Join(t.TempDir(), "missing.db"))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, backup.ErrInvalidBackup)
+}
+
+func TestSetBackupInterval(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	tests := []struct {
+		interval int
+		cron     string
+	}{
+		{interval: 30, cron: "*/1 * * * *"},
+		{interval: 300, cron: "*/5 * * * *"},
+		{interval: 420, cron: "*/6 * * * *"},
+		{interval: 3600, cron: "0 */1 * * *"},
+		{interval: 18000, cron: "0 */4 * * *"},
+		{interval: 86400, cron: "0 0 * * *"},
+		{interval: 604800, cron: "0 0 * * *"},
+	}
+	for _, test := range tests {
+		require.NoError(t, backup.SetBackupInterval(ctx, ts, test.interval))
+		backupConfig, err := backup.GetBackupConfig(ctx, ts)
+		require.NoError(t, err)
+		require.True(t, backupConfig.Enabled)
+		require.Equal(t, test.cron, backupConfig.Cron)
+	}
+
+	require.NoError(t, backup.SetBackupInterval(ctx, ts, 0))
+	backupConfig, err := backup.GetBackupConfig(ctx, ts)
+	require.NoError(t, err)
+	require.False(t, backupConfig.Enabled)
+}
@@ -51,3 +51,37 @@ func TestInboxStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(inboxes))
 }
+
+func TestInboxStorePagination(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err := ts.CreateInbox(ctx, &store.Inbox{
+			SenderID:   store.SystemBotID,
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type: storepb.InboxMessage_TYPE_MEMO_COMMENT,
+			},
+		})
+		require.NoError(t, err)
+	}
+	limit, offset := 2, 2
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+		Limit:      &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(inboxes))
+	// The latest inbox comes first.
+	require.Greater(t, inboxes[0].ID, inboxes[1].ID)
+	inboxes, err = ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+		Limit:      &limit,
+		Offset:     &offset,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inboxes))
+}
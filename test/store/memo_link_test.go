@@ -0,0 +1,72 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestReconcileMemoLinks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	otherUser, err := ts.CreateUser(ctx, &store.User{
+		Username: "other",
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+	})
+	require.NoError(t, err)
+
+	weekly, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: user.ID, Content: "# Weekly notes\nmonday", Visibility: store.Private})
+	require.NoError(t, err)
+	target, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: user.ID, Content: "target", Visibility: store.Private})
+	require.NoError(t, err)
+	otherPrivate, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: otherUser.ID, Content: "# Weekly notes", Visibility: store.Private})
+	require.NoError(t, err)
+	manual, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: user.ID, Content: "manual", Visibility: store.Private})
+	require.NoError(t, err)
+
+	// The links are reconciled by the writes of the content.
+	content := fmt.Sprintf("See [[memo:%d]], [[weekly notes]], [[memo:%d]] and [[Missing]]", target.ID, otherPrivate.ID)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: user.ID, Content: content, Visibility: store.Private})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: memo.ID, RelatedMemoID: manual.ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+	unresolvedTargets, err := ts.ListUnresolvedMemoLinks(ctx, memo)
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("memo:%d", otherPrivate.ID), "Missing"}, unresolvedTargets)
+	require.Equal(t, []int32{weekly.ID, target.ID, manual.ID}, listRelatedMemoIDs(ctx, t, ts, memo.ID))
+
+	// Only the relations of the removed links are deleted, so the manual relation is kept.
+	memo.Content = "See [[Weekly notes]]"
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &memo.Content}))
+	unresolvedTargets, err = ts.ListUnresolvedMemoLinks(ctx, memo)
+	require.NoError(t, err)
+	require.Empty(t, unresolvedTargets)
+	require.Equal(t, []int32{weekly.ID, manual.ID}, listRelatedMemoIDs(ctx, t, ts, memo.ID))
+
+	// The relations removed by hand are restored by reconciling with the same content.
+	referenceType := store.MemoRelationReference
+	require.NoError(t, ts.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID, RelatedMemoID: &weekly.ID, Type: &referenceType}))
+	_, err = ts.ReconcileMemoLinks(ctx, memo, memo.Content)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int32{weekly.ID, manual.ID}, listRelatedMemoIDs(ctx, t, ts, memo.ID))
+	ts.Close()
+}
+
+func listRelatedMemoIDs(ctx context.Context, t *testing.T, ts *store.Store, memoID int32) []int32 {
+	memoRelations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID: &memoID,
+	})
+	require.NoError(t, err)
+	relatedMemoIDs := []int32{}
+	for _, memoRelation := range memoRelations {
+		relatedMemoIDs = append(relatedMemoIDs, memoRelation.RelatedMemoID)
+	}
+	return relatedMemoIDs
+}
@@ -56,3 +56,49 @@ func TestMemoRelationStore(t *testing.T) {
 	_, err = ts.UpsertMemoRelation(ctx, commentRelation)
 	require.NoError(t, err)
 }
+
+func TestMemoRelationStoreListByCreator(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	otherUser, err := ts.CreateUser(ctx, &store.User{
+		Username: "other",
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: user.ID, Content: "memo", Visibility: store.Public})
+	require.NoError(t, err)
+	relatedMemo, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: user.ID, Content: "related", Visibility: store.Public})
+	require.NoError(t, err)
+	otherMemo, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: otherUser.ID, Content: "other", Visibility: store.Public})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        memo.ID,
+		RelatedMemoID: relatedMemo.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        otherMemo.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+
+	// Only the relations from the memos of the user are listed.
+	memoRelations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, memoRelations, 1)
+	require.Equal(t, memo.ID, memoRelations[0].MemoID)
+	memoRelations, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		CreatorID: &otherUser.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, memoRelations, 1)
+	require.Equal(t, otherMemo.ID, memoRelations[0].MemoID)
+}
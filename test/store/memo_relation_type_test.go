@@ -0,0 +1,99 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRelationTypeStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	otherUser, err := ts.CreateUser(ctx, &store.User{
+		Username: "other",
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+	})
+	require.NoError(t, err)
+
+	claim, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: user.ID, Content: "claim", Visibility: store.Private})
+	require.NoError(t, err)
+	evidence, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: user.ID, Content: "evidence", Visibility: store.Private})
+	require.NoError(t, err)
+	otherMemo, err := ts.CreateMemo(ctx, &store.Memo{CreatorID: otherUser.ID, Content: "other", Visibility: store.Public})
+	require.NoError(t, err)
+
+	_, err = ts.CreateMemoRelationTypeDefinition(ctx, &store.MemoRelationTypeDefinition{CreatorID: user.ID, Name: "Supports"})
+	require.Error(t, err)
+	supports, err := ts.CreateMemoRelationTypeDefinition(ctx, &store.MemoRelationTypeDefinition{
+		CreatorID:   user.ID,
+		Name:        "supports",
+		InverseName: "supported-by",
+		Directed:    true,
+	})
+	require.NoError(t, err)
+	related, err := ts.CreateMemoRelationTypeDefinition(ctx, &store.MemoRelationTypeDefinition{
+		CreatorID: user.ID,
+		Name:      "related",
+		Directed:  false,
+	})
+	require.NoError(t, err)
+	require.False(t, related.Directed)
+	definitionList, err := ts.ListMemoRelationTypeDefinitions(ctx, &store.FindMemoRelationTypeDefinition{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"related", "supports"}, []string{definitionList[0].Name, definitionList[1].Name})
+
+	inverseName := "backed-by"
+	supports, err = ts.UpdateMemoRelationTypeDefinition(ctx, &store.UpdateMemoRelationTypeDefinition{ID: supports.ID, InverseName: &inverseName})
+	require.NoError(t, err)
+	require.Equal(t, "backed-by", supports.InverseName)
+
+	// The unknown types, and the custom types between the memos of different users, are rejected.
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: evidence.ID, RelatedMemoID: claim.ID, Type: "refutes"})
+	require.Error(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: evidence.ID, RelatedMemoID: otherMemo.ID, Type: "supports"})
+	require.Error(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: otherMemo.ID, RelatedMemoID: claim.ID, Type: "supports"})
+	require.Error(t, err)
+	// The builtin types still relate any memos.
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: evidence.ID, RelatedMemoID: otherMemo.ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: evidence.ID, RelatedMemoID: claim.ID, Type: "supports"})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: evidence.ID, RelatedMemoID: claim.ID, Type: "supports"})
+	require.NoError(t, err)
+	memoRelationList, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &evidence.ID})
+	require.NoError(t, err)
+	require.Len(t, memoRelationList, 2)
+	memoRelationList, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &claim.ID})
+	require.NoError(t, err)
+	require.Len(t, memoRelationList, 0)
+
+	// The undirected relations are kept in both directions.
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: claim.ID, RelatedMemoID: evidence.ID, Type: "related"})
+	require.NoError(t, err)
+	memoRelationList, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &evidence.ID, TypeList: []store.MemoRelationType{"related", "supports"}})
+	require.NoError(t, err)
+	require.Len(t, memoRelationList, 2)
+	relatedType := store.MemoRelationType("related")
+	err = ts.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &evidence.ID, RelatedMemoID: &claim.ID, Type: &relatedType})
+	require.NoError(t, err)
+	memoRelationList, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{Type: &relatedType})
+	require.NoError(t, err)
+	require.Len(t, memoRelationList, 0)
+
+	// Deleting a type deletes its relations.
+	err = ts.DeleteMemoRelationTypeDefinition(ctx, &store.DeleteMemoRelationTypeDefinition{ID: supports.ID})
+	require.NoError(t, err)
+	memoRelationList, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &evidence.ID})
+	require.NoError(t, err)
+	require.Len(t, memoRelationList, 1)
+	require.Equal(t, store.MemoRelationReference, memoRelationList[0].Type)
+	ts.Close()
+}
@@ -0,0 +1,82 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		CreatorID:  user.ID,
+		Content:    "first version",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	memoRevisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoRevisions))
+
+	// Updating the content keeps the replaced content as a revision.
+	for _, content := range []string{"second version", "third version"} {
+		content := content
+		err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memo.ID,
+			Content: &content,
+		})
+		require.NoError(t, err)
+	}
+	// Updating other fields or saving the same content does not.
+	visibility := store.Private
+	content := "third version"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:         memo.ID,
+		Content:    &content,
+		Visibility: &visibility,
+	})
+	require.NoError(t, err)
+	memoRevisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoRevisions))
+	require.Equal(t, "second version", memoRevisions[0].Content)
+	require.Equal(t, "first version", memoRevisions[1].Content)
+	require.Equal(t, user.ID, memoRevisions[0].CreatorID)
+
+	memoRevision, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID: &memoRevisions[1].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, memoRevisions[1], memoRevision)
+
+	err = ts.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{
+		ID: &memoRevision.ID,
+	})
+	require.NoError(t, err)
+	memoRevisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoRevisions))
+
+	// Deleting the memo removes its revisions.
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{
+		ID: memo.ID,
+	})
+	require.NoError(t, err)
+	memoRevisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoRevisions))
+}
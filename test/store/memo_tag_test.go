@@ -0,0 +1,115 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestExtractMemoTags(t *testing.T) {
+	tests := []struct {
+		content string
+		tags    []string
+	}{
+		{
+			content: "no tags here",
+			tags:    []string{},
+		},
+		{
+			content: "#work meeting with #life and #work again",
+			tags:    []string{"life", "work"},
+		},
+		{
+			content: "# Heading\n> quoted #quote\n#work/project",
+			tags:    []string{"quote", "work/project"},
+		},
+		{
+			content: "```\n#not-a-tag\n```\n`#code` #tag",
+			tags:    []string{"tag"},
+		},
+	}
+	for _, test := range tests {
+		tags, err := store.ExtractMemoTags(test.content)
+		require.NoError(t, err)
+		require.Equal(t, test.tags, tags, test.content)
+	}
+}
+
+func TestMemoTagStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	createMemo := func(content string) *store.Memo {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	meeting := createMemo("#work meeting")
+	project := createMemo("#work/project and #work/meeting")
+	workout := createMemo("#workout")
+	listTagCounts := func(excludeImplicit bool) map[string]int {
+		memoTagCounts, err := ts.ListMemoTagCounts(ctx, &store.FindMemoTag{
+			CreatorID:       &user.ID,
+			ExcludeImplicit: excludeImplicit,
+		})
+		require.NoError(t, err)
+		tagCounts := map[string]int{}
+		for _, memoTagCount := range memoTagCounts {
+			tagCounts[memoTagCount.Tag] = memoTagCount.MemoCount
+		}
+		return tagCounts
+	}
+	listTagMemoIDs := func(tag string) []int32 {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{
+			Tag: &tag,
+		})
+		require.NoError(t, err)
+		ids := []int32{}
+		for _, memo := range memos {
+			ids = append(ids, memo.ID)
+		}
+		return ids
+	}
+
+	// A parent tag counts each memo of its sub tags once.
+	require.Equal(t, map[string]int{"work": 2, "work/project": 1, "work/meeting": 1, "workout": 1}, listTagCounts(false))
+	require.Equal(t, map[string]int{"work": 1, "work/project": 1, "work/meeting": 1, "workout": 1}, listTagCounts(true))
+	require.ElementsMatch(t, []int32{meeting.ID, project.ID}, listTagMemoIDs("work"))
+	require.ElementsMatch(t, []int32{project.ID}, listTagMemoIDs("work/project"))
+	require.ElementsMatch(t, []int32{workout.ID}, listTagMemoIDs("workout"))
+	require.Empty(t, listTagMemoIDs("wor"))
+
+	// The index follows the content updates and deletions.
+	content := "#life/health"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      workout.ID,
+		Content: &content,
+	})
+	require.NoError(t, err)
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{
+		ID: meeting.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"work": 1, "work/project": 1, "work/meeting": 1, "life": 1, "life/health": 1}, listTagCounts(false))
+	require.ElementsMatch(t, []int32{workout.ID}, listTagMemoIDs("life"))
+
+	// The backfill indexes the memos once.
+	err = ts.UpsertMemoTags(ctx, project.ID, "")
+	require.NoError(t, err)
+	err = ts.BackfillMemoTags(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int32{project.ID}, listTagMemoIDs("work"))
+	err = ts.UpsertMemoTags(ctx, project.ID, "")
+	require.NoError(t, err)
+	err = ts.BackfillMemoTags(ctx)
+	require.NoError(t, err)
+	require.Empty(t, listTagMemoIDs("work"))
+}
@@ -59,3 +59,268 @@ func TestMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(memoList))
 }
+
+func TestMemoSearchStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for _, content := range []string{
+		"the quick brown fox jumps over the lazy dog",
+		"a quick start guide for memos",
+		"brown bread recipe",
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+
+	searchMemos := func(query string) []*store.Memo {
+		memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+			CreatorID:   &user.ID,
+			SearchQuery: &query,
+		})
+		require.NoError(t, err)
+		return memoList
+	}
+
+	memoList := searchMemos("quick")
+	require.Equal(t, 2, len(memoList))
+	require.Contains(t, memoList[0].Snippet, "<mark>quick</mark>")
+	require.Equal(t, 1, len(searchMemos(`"quick brown"`)))
+	require.Equal(t, 2, len(searchMemos("bro*")))
+	require.Equal(t, 3, len(searchMemos("quick OR bread")))
+	require.Equal(t, 1, len(searchMemos("brown NOT fox")))
+	require.Equal(t, 1, len(searchMemos("(brown AND) dog:")))
+	require.Equal(t, 0, len(searchMemos("AND (")))
+
+	// The search index follows the memo content updates.
+	memo := searchMemos("bread")[0]
+	content := "sourdough recipe"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &content,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(searchMemos("bread")))
+	require.Equal(t, 1, len(searchMemos("sourdough")))
+
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{
+		ID: memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(searchMemos("sourdough")))
+
+	// The CJK words are matched inside the sentences, and the short ones without the full-text index.
+	for _, content := range []string{
+		"今天我们讨论全文搜索的实现",
+		"搜索引擎的原理",
+		"done_ok",
+		"memos to do",
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+	memoList = searchMemos("全文搜索")
+	require.Equal(t, 1, len(memoList))
+	require.Contains(t, memoList[0].Snippet, "<mark>全文搜索</mark>")
+	require.Equal(t, 2, len(searchMemos("搜索")))
+	require.Equal(t, 1, len(searchMemos("搜索 原理")))
+	require.Equal(t, 2, len(searchMemos("搜索。")))
+	require.Equal(t, 1, len(searchMemos("do e_o")))
+
+	// The operators are kept when the short terms are searched without the full-text index.
+	for _, content := range []string{
+		"learning go",
+		"go with rust",
+		"js tricks",
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+	memoList = searchMemos("go NOT rust")
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, "learning go", memoList[0].Content)
+	require.Equal(t, 3, len(searchMemos("go OR js")))
+	require.Equal(t, 2, len(searchMemos("(go OR js) NOT rust")))
+	require.Equal(t, 1, len(searchMemos("go rust")))
+}
+
+func TestMemoFilterStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memoIDs := map[string]int32{}
+	for _, content := range []string{
+		"#work meeting notes",
+		"#work/project\n- [ ] write the plan",
+		"#workout 100% done",
+		"private thoughts",
+	} {
+		visibility := store.Public
+		if content == "private thoughts" {
+			visibility = store.Private
+		}
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: visibility,
+		})
+		require.NoError(t, err)
+		memoIDs[content] = memo.ID
+	}
+	_, err = ts.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+		MemoID: memoIDs["#work meeting notes"],
+		UserID: user.ID,
+		Pinned: true,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        memoIDs["private thoughts"],
+		RelatedMemoID: memoIDs["#workout 100% done"],
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+
+	condition := func(field store.MemoFilterField, operator store.MemoFilterOperator, value any) *store.MemoFilter {
+		return &store.MemoFilter{
+			Kind:     store.MemoFilterCondition,
+			Field:    field,
+			Operator: operator,
+			Value:    value,
+		}
+	}
+	filterMemos := func(filter *store.MemoFilter) []string {
+		memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+			CreatorID: &user.ID,
+			Filter:    filter,
+		})
+		require.NoError(t, err)
+		contents := []string{}
+		for _, memo := range memoList {
+			contents = append(contents, memo.Content)
+		}
+		return contents
+	}
+
+	require.ElementsMatch(t, []string{"#work meeting notes", "#work/project\n- [ ] write the plan"}, filterMemos(condition(store.MemoFilterTag, store.MemoFilterEqual, "work")))
+	require.ElementsMatch(t, []string{"#workout 100% done", "private thoughts"}, filterMemos(condition(store.MemoFilterTag, store.MemoFilterNotEqual, "work")))
+	require.ElementsMatch(t, []string{"#workout 100% done"}, filterMemos(condition(store.MemoFilterContentSearch, store.MemoFilterEqual, "100%")))
+	require.ElementsMatch(t, []string{"#work/project\n- [ ] write the plan"}, filterMemos(condition(store.MemoFilterHasTask, store.MemoFilterEqual, true)))
+	require.ElementsMatch(t, []string{"#work meeting notes"}, filterMemos(condition(store.MemoFilterPinned, store.MemoFilterEqual, true)))
+	require.ElementsMatch(t, []string{"private thoughts"}, filterMemos(condition(store.MemoFilterRelatedTo, store.MemoFilterIn, []any{int64(memoIDs["#workout 100% done"])})))
+	require.Empty(t, filterMemos(condition(store.MemoFilterVisibility, store.MemoFilterIn, []any{})))
+
+	// NOT (visibility == "PUBLIC" AND (pinned OR has_task))
+	require.ElementsMatch(t, []string{"#workout 100% done", "private thoughts"}, filterMemos(&store.MemoFilter{
+		Kind: store.MemoFilterNot,
+		Children: []*store.MemoFilter{
+			{
+				Kind: store.MemoFilterAnd,
+				Children: []*store.MemoFilter{
+					condition(store.MemoFilterVisibility, store.MemoFilterEqual, string(store.Public)),
+					{
+						Kind: store.MemoFilterOr,
+						Children: []*store.MemoFilter{
+							condition(store.MemoFilterPinned, store.MemoFilterEqual, true),
+							condition(store.MemoFilterHasTask, store.MemoFilterEqual, true),
+						},
+					},
+				},
+			},
+		},
+	}))
+
+	_, err = ts.ListMemos(ctx, &store.FindMemo{
+		Filter: condition(store.MemoFilterTag, store.MemoFilterLess, "work"),
+	})
+	require.Error(t, err)
+}
+
+func TestLowerMemoFilter(t *testing.T) {
+	condition := func(field store.MemoFilterField, operator store.MemoFilterOperator, value any) *store.MemoFilter {
+		return &store.MemoFilter{
+			Kind:     store.MemoFilterCondition,
+			Field:    field,
+			Operator: operator,
+			Value:    value,
+		}
+	}
+	and := func(children ...*store.MemoFilter) *store.MemoFilter {
+		return &store.MemoFilter{
+			Kind:     store.MemoFilterAnd,
+			Children: children,
+		}
+	}
+	ts := func(v int64) *int64 {
+		return &v
+	}
+
+	find, matchable, err := store.LowerMemoFilter(&store.FindMemo{
+		Filter: and(
+			condition(store.MemoFilterVisibility, store.MemoFilterEqual, string(store.Public)),
+			condition(store.MemoFilterCreatedTs, store.MemoFilterGreaterOrEqual, int64(100)),
+			condition(store.MemoFilterCreatedTs, store.MemoFilterLess, int64(200)),
+			condition(store.MemoFilterTag, store.MemoFilterEqual, "work"),
+			condition(store.MemoFilterContentSearch, store.MemoFilterEqual, "meeting"),
+		),
+	})
+	require.NoError(t, err)
+	require.True(t, matchable)
+	require.Nil(t, find.Filter)
+	require.Equal(t, []store.Visibility{store.Public}, find.VisibilityList)
+	require.Equal(t, ts(99), find.CreatedTsAfter)
+	require.Equal(t, ts(200), find.CreatedTsBefore)
+	require.Equal(t, "work", *find.Tag)
+	require.Equal(t, []string{"meeting"}, find.ContentSearch)
+
+	// The conditions are intersected with the fields of the find.
+	find, matchable, err = store.LowerMemoFilter(&store.FindMemo{
+		VisibilityList:  []store.Visibility{store.Public, store.Protected},
+		CreatedTsBefore: ts(150),
+		Filter:          condition(store.MemoFilterVisibility, store.MemoFilterIn, []any{string(store.Protected), string(store.Private)}),
+	})
+	require.NoError(t, err)
+	require.True(t, matchable)
+	require.Equal(t, []store.Visibility{store.Protected}, find.VisibilityList)
+	require.Equal(t, ts(150), find.CreatedTsBefore)
+
+	_, matchable, err = store.LowerMemoFilter(&store.FindMemo{
+		VisibilityList: []store.Visibility{store.Public},
+		Filter:         condition(store.MemoFilterVisibility, store.MemoFilterEqual, string(store.Private)),
+	})
+	require.NoError(t, err)
+	require.False(t, matchable)
+	_, matchable, err = store.LowerMemoFilter(&store.FindMemo{
+		Filter: and(
+			condition(store.MemoFilterCreatedTs, store.MemoFilterGreater, int64(100)),
+			condition(store.MemoFilterCreatedTs, store.MemoFilterLessOrEqual, int64(100)),
+		),
+	})
+	require.NoError(t, err)
+	require.False(t, matchable)
+
+	for _, filter := range []*store.MemoFilter{
+		condition(store.MemoFilterHasTask, store.MemoFilterEqual, true),
+		condition(store.MemoFilterTag, store.MemoFilterNotEqual, "work"),
+		condition(store.MemoFilterVisibility, store.MemoFilterNotEqual, string(store.Private)),
+		and(condition(store.MemoFilterTag, store.MemoFilterEqual, "work"), condition(store.MemoFilterTag, store.MemoFilterEqual, "home")),
+		{Kind: store.MemoFilterOr, Children: []*store.MemoFilter{condition(store.MemoFilterTag, store.MemoFilterEqual, "work")}},
+		{Kind: store.MemoFilterNot, Children: []*store.MemoFilter{condition(store.MemoFilterTag, store.MemoFilterEqual, "work")}},
+	} {
+		_, _, err := store.LowerMemoFilter(&store.FindMemo{Filter: filter})
+		require.ErrorIs(t, err, store.ErrMemoFilterNotSupported)
+	}
+}
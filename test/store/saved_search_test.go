@@ -0,0 +1,78 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestSavedSearchStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	todo, err := ts.CreateSavedSearch(ctx, &storepb.SavedSearch{
+		CreatorId: user.ID,
+		Name:      "todo",
+		Filter:    `has_task == true`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "todo", todo.Name)
+	require.Equal(t, user.ID, todo.CreatorId)
+	require.Equal(t, storepb.SavedSearch_ORDER_UNSPECIFIED, todo.Order)
+	work, err := ts.CreateSavedSearch(ctx, &storepb.SavedSearch{
+		CreatorId:      user.ID,
+		Name:           "work",
+		Filter:         `tag == "work"`,
+		Order:          storepb.SavedSearch_UPDATED_TS_DESC,
+		PinnedPosition: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.SavedSearch_UPDATED_TS_DESC, work.Order)
+
+	// The names are unique for the creator.
+	_, err = ts.CreateSavedSearch(ctx, &storepb.SavedSearch{
+		CreatorId: user.ID,
+		Name:      "todo",
+	})
+	require.Error(t, err)
+
+	// The pinned saved searches come first.
+	savedSearches, err := ts.ListSavedSearches(ctx, &store.FindSavedSearch{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*storepb.SavedSearch{work, todo}, savedSearches)
+	name := "todo"
+	savedSearch, err := ts.GetSavedSearch(ctx, &store.FindSavedSearch{
+		CreatorID: &user.ID,
+		Name:      &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, todo, savedSearch)
+
+	newName, pinnedPosition := "tasks", int32(2)
+	updatedSavedSearch, err := ts.UpdateSavedSearch(ctx, &store.UpdateSavedSearch{
+		ID:             todo.Id,
+		Name:           &newName,
+		PinnedPosition: &pinnedPosition,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newName, updatedSavedSearch.Name)
+	require.Equal(t, pinnedPosition, updatedSavedSearch.PinnedPosition)
+	require.Equal(t, todo.Filter, updatedSavedSearch.Filter)
+
+	err = ts.DeleteSavedSearch(ctx, &store.DeleteSavedSearch{
+		ID: work.Id,
+	})
+	require.NoError(t, err)
+	savedSearches, err = ts.ListSavedSearches(ctx, &store.FindSavedSearch{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*storepb.SavedSearch{updatedSavedSearch}, savedSearches)
+}
@@ -0,0 +1,144 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+	"github.com/usememos/memos/test"
+)
+
+func TestRewriteTag(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	createMemo := func(content string) *store.Memo {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	getContent := func(memo *store.Memo) string {
+		memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+		require.NoError(t, err)
+		return memo.Content
+	}
+	meeting := createMemo("#work meeting")
+	project := createMemo("#work/project `#work`")
+	workshop := createMemo("#workshop")
+	_, err = ts.UpsertTag(ctx, &store.Tag{Name: "work", CreatorID: user.ID})
+	require.NoError(t, err)
+
+	// Dry run lists the memos without changing them.
+	memoIDs, err := ts.RewriteTag(ctx, &store.RewriteTag{
+		CreatorID:      user.ID,
+		SourceTags:     []string{"work"},
+		TargetTag:      "job",
+		IncludeSubTags: true,
+		DryRun:         true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{meeting.ID, project.ID}, memoIDs)
+	require.Equal(t, "#work meeting", getContent(meeting))
+
+	// Rename with the sub tags.
+	memoIDs, err = ts.RewriteTag(ctx, &store.RewriteTag{
+		CreatorID:      user.ID,
+		SourceTags:     []string{"work"},
+		TargetTag:      "job",
+		IncludeSubTags: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{meeting.ID, project.ID}, memoIDs)
+	require.Equal(t, "#job meeting", getContent(meeting))
+	require.Equal(t, "#job/project `#work`", getContent(project))
+	require.Equal(t, "#workshop", getContent(workshop))
+	tags, err := ts.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	require.Equal(t, "job", tags[0].Name)
+	memoRevisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &meeting.ID})
+	require.NoError(t, err)
+	require.Len(t, memoRevisions, 1)
+	require.Equal(t, "#work meeting", memoRevisions[0].Content)
+	tag := "job/project"
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Tag: &tag})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+
+	// Merge and delete.
+	_, err = ts.RewriteTag(ctx, &store.RewriteTag{
+		CreatorID:  user.ID,
+		SourceTags: []string{"job/project", "workshop"},
+		TargetTag:  "job",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "#job `#work`", getContent(project))
+	require.Equal(t, "#job", getContent(workshop))
+	memoIDs, err = ts.RewriteTag(ctx, &store.RewriteTag{
+		CreatorID:  user.ID,
+		SourceTags: []string{"job"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{meeting.ID, project.ID, workshop.ID}, memoIDs)
+	require.Equal(t, "meeting", getContent(meeting))
+	tags, err = ts.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Len(t, tags, 0)
+
+	_, err = ts.RewriteTag(ctx, &store.RewriteTag{
+		CreatorID:  user.ID,
+		SourceTags: []string{"work"},
+		TargetTag:  "two words",
+	})
+	require.Error(t, err)
+	ts.Close()
+}
+
+func TestApplyTagRewriteConflict(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	require.NoError(t, dbDriver.Migrate(ctx))
+	ts := store.New(dbDriver, profile)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		CreatorID:  user.ID,
+		Content:    "#work meeting",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	content := "#work meeting notes"
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content}))
+
+	// The rewrite of the content read before the update is not applied.
+	err = dbDriver.ApplyTagRewrite(ctx, &store.ApplyTagRewrite{
+		CreatorID: user.ID,
+		Memos: []*store.MemoContentRewrite{
+			{
+				MemoID:          memo.ID,
+				CreatorID:       user.ID,
+				PreviousContent: "#work meeting",
+				Content:         "#job meeting",
+			},
+		},
+		TagRenames: []*store.TagRename{{From: "work", To: "job"}},
+	})
+	require.ErrorIs(t, err, store.ErrTagRewriteConflict)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, content, memo.Content)
+	memoRevisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, memoRevisions, 1)
+	ts.Close()
+}
@@ -0,0 +1,71 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestGetTagStats(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	january := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC).Unix()
+	february := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC).Unix()
+	createMemo := func(content string, createdTs int64) {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        memo.ID,
+			CreatedTs: &createdTs,
+		}))
+	}
+	createMemo("#work/project #life", january)
+	createMemo("#work/meeting #life", february)
+	createMemo("#work", february)
+
+	tagStats, err := ts.GetTagStats(ctx, &store.FindTagStats{
+		CreatorID: user.ID,
+		RootTag:   "work",
+	})
+	require.NoError(t, err)
+	require.Len(t, tagStats.Usages, 4)
+	work := tagStats.Usages[1]
+	require.Equal(t, "work", work.Tag)
+	require.Equal(t, 3, work.MemoCount)
+	require.Equal(t, january, work.FirstUsedTs)
+	require.Equal(t, february, work.LastUsedTs)
+	require.Equal(t, []*store.TagMonthlyUsage{{Month: "2024-01", MemoCount: 1}, {Month: "2024-02", MemoCount: 2}}, work.MonthlyUsages)
+	// A tag never co-occurs with its parent tags.
+	require.Equal(t, []*store.TagCoOccurrence{
+		{Tag: "life", OtherTag: "work", MemoCount: 2},
+		{Tag: "life", OtherTag: "work/meeting", MemoCount: 1},
+		{Tag: "life", OtherTag: "work/project", MemoCount: 1},
+	}, tagStats.CoOccurrences)
+	require.Equal(t, []*store.TagCoOccurrence{{Tag: "work", OtherTag: "life", MemoCount: 2}}, tagStats.Neighbors)
+	require.Equal(t, "work", tagStats.Tree.Tag)
+	require.Equal(t, 3, tagStats.Tree.MemoCount)
+	require.Len(t, tagStats.Tree.Children, 2)
+	require.Equal(t, "work/meeting", tagStats.Tree.Children[0].Tag)
+
+	// The exact mode only counts the tags written in the memos.
+	tagStats, err = ts.GetTagStats(ctx, &store.FindTagStats{
+		CreatorID: user.ID,
+		Exact:     true,
+		RootTag:   "work",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, tagStats.Usages[1].MemoCount)
+	require.Len(t, tagStats.Neighbors, 0)
+	require.Equal(t, 1, tagStats.Tree.MemoCount)
+	ts.Close()
+}
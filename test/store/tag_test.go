@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -26,7 +27,8 @@ func TestTagStore(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(tags))
-	require.Equal(t, tag, tags[0])
+	require.Equal(t, tag.Name, tags[0].Name)
+	require.Equal(t, tag.CreatorID, tags[0].CreatorID)
 	err = ts.DeleteTag(ctx, &store.DeleteTag{
 		Name:      "test_tag",
 		CreatorID: user.ID,
@@ -36,3 +38,95 @@ func TestTagStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(tags))
 }
+
+func TestTagMetadata(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		CreatorID:  user.ID,
+		Content:    "#work/project meeting",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "work",
+		Payload:   &storepb.TagPayload{Color: "#16a34a", SortWeight: 1},
+		Aliases:   []string{"job"},
+	})
+	require.NoError(t, err)
+
+	// Upserting without payload keeps the metadata.
+	_, err = ts.UpsertTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "work",
+	})
+	require.NoError(t, err)
+	name := "work"
+	tag, err := ts.GetTag(ctx, &store.FindTag{
+		CreatorID: user.ID,
+		Name:      &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "#16a34a", tag.Payload.Color)
+	require.Equal(t, []string{"job"}, tag.Aliases)
+
+	// An alias matches the memos with the tag.
+	alias := "job"
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Tag: &alias})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, memo.ID, memos[0].ID)
+
+	// Renaming the tag moves its metadata.
+	_, err = ts.RewriteTag(ctx, &store.RewriteTag{
+		CreatorID:  user.ID,
+		SourceTags: []string{"work"},
+		TargetTag:  "career",
+	})
+	require.NoError(t, err)
+	tags, err := ts.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	require.Equal(t, "career", tags[0].Name)
+	require.Equal(t, "#16a34a", tags[0].Payload.Color)
+	require.Equal(t, []string{"job"}, tags[0].Aliases)
+	ts.Close()
+}
+
+func TestMigrateFavoriteTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "life",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSettingV1(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_FAV_TAG,
+		Value:  &storepb.UserSetting_FavTag{FavTag: "work, life,"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.MigrateFavoriteTags(ctx))
+	tags, err := ts.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	for _, tag := range tags {
+		require.True(t, tag.Payload.Favorite, tag.Name)
+	}
+
+	// The migration runs once.
+	require.NoError(t, ts.SetFavoriteTags(ctx, user.ID, []string{"work"}))
+	require.NoError(t, ts.MigrateFavoriteTags(ctx))
+	tags, err = ts.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.False(t, tags[0].Payload.Favorite)
+	require.True(t, tags[1].Payload.Favorite)
+	ts.Close()
+}
@@ -25,3 +25,81 @@ func TestUserSettingStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(list))
 }
+
+func TestUserSettingStoreTelegramUserID(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	userID, err := ts.GetUserIDByTelegramUserID(ctx, "123456789")
+	require.NoError(t, err)
+	require.Equal(t, int32(0), userID)
+	_, err = ts.UpsertUserSettingV1(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID,
+		Value:  &storepb.UserSetting_TelegramUserId{TelegramUserId: "123456789"},
+	})
+	require.NoError(t, err)
+	userID, err = ts.GetUserIDByTelegramUserID(ctx, "123456789")
+	require.NoError(t, err)
+	require.Equal(t, user.ID, userID)
+	userID, err = ts.GetUserIDByTelegramUserID(ctx, "987654321")
+	require.NoError(t, err)
+	require.Equal(t, int32(0), userID)
+}
+
+func TestUserSettingStoreTelegramLinkCode(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	otherUser, err := ts.CreateUser(ctx, &store.User{
+		Username: "other",
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+	})
+	require.NoError(t, err)
+
+	_, err = ts.LinkTelegramUser(ctx, "UNKNOWN", "123456789")
+	require.ErrorIs(t, err, store.ErrInvalidTelegramLinkCode)
+
+	code, _, err := ts.CreateTelegramLinkCode(user.ID)
+	require.NoError(t, err)
+	userID, err := ts.LinkTelegramUser(ctx, code, "123456789")
+	require.NoError(t, err)
+	require.Equal(t, user.ID, userID)
+	userID, err = ts.GetUserIDByTelegramUserID(ctx, "123456789")
+	require.NoError(t, err)
+	require.Equal(t, user.ID, userID)
+
+	// The codes can be used only once.
+	_, err = ts.LinkTelegramUser(ctx, code, "123456789")
+	require.ErrorIs(t, err, store.ErrInvalidTelegramLinkCode)
+
+	// Linking the telegram account to another user moves it from the first one.
+	code, _, err = ts.CreateTelegramLinkCode(otherUser.ID)
+	require.NoError(t, err)
+	userID, err = ts.LinkTelegramUser(ctx, code, "123456789")
+	require.NoError(t, err)
+	require.Equal(t, otherUser.ID, userID)
+	userID, err = ts.GetUserIDByTelegramUserID(ctx, "123456789")
+	require.NoError(t, err)
+	require.Equal(t, otherUser.ID, userID)
+	userSetting, err := ts.GetUserSettingV1(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_TELEGRAM_USER_ID,
+	})
+	require.NoError(t, err)
+	require.Empty(t, userSetting.GetTelegramUserId())
+
+	// A new code replaces the previous code of the user.
+	previousCode, _, err := ts.CreateTelegramLinkCode(user.ID)
+	require.NoError(t, err)
+	code, _, err = ts.CreateTelegramLinkCode(user.ID)
+	require.NoError(t, err)
+	_, err = ts.LinkTelegramUser(ctx, previousCode, "987654321")
+	require.ErrorIs(t, err, store.ErrInvalidTelegramLinkCode)
+	userID, err = ts.LinkTelegramUser(ctx, code, "987654321")
+	require.NoError(t, err)
+	require.Equal(t, user.ID, userID)
+}
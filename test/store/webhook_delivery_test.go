@@ -0,0 +1,231 @@
+package teststore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	webhookdispatcher "github.com/usememos/memos/server/service/webhook_dispatcher"
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	hook, err := ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "test_webhook",
+		Url:       "https://example.com",
+	})
+	require.NoError(t, err)
+	now := time.Now().Unix()
+	delivery, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		WebhookID:     hook.Id,
+		ActivityType:  "memos.memo.created",
+		Payload:       "{}",
+		Attempt:       1,
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliveryPending, delivery.Status)
+
+	pendingStatus := store.WebhookDeliveryPending
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		Status:              &pendingStatus,
+		NextAttemptTsBefore: &now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(deliveries))
+	require.Equal(t, delivery, deliveries[0])
+
+	succeededStatus := store.WebhookDeliverySucceeded
+	statusCode := int32(200)
+	responseBody := "ok"
+	updatedDelivery, err := ts.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:           delivery.ID,
+		Status:       &succeededStatus,
+		StatusCode:   &statusCode,
+		ResponseBody: &responseBody,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliverySucceeded, updatedDelivery.Status)
+	require.Equal(t, statusCode, updatedDelivery.StatusCode)
+	require.Equal(t, responseBody, updatedDelivery.ResponseBody)
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		Status: &pendingStatus,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(deliveries))
+
+	// Deleting the webhook removes its deliveries with the vacuum.
+	err = ts.DeleteWebhook(ctx, &store.DeleteWebhook{
+		ID: hook.Id,
+	})
+	require.NoError(t, err)
+	err = ts.Vacuum(ctx)
+	require.NoError(t, err)
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		WebhookID: &hook.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(deliveries))
+}
+
+func TestWebhookDispatcherRetry(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	responseStatus := http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(responseStatus)
+	}))
+	defer server.Close()
+	hook, err := ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "test_webhook",
+		Url:       server.URL,
+		Secret:    "secret",
+	})
+	require.NoError(t, err)
+
+	dispatcher := webhookdispatcher.NewWebhookDispatcher(ts)
+	delivery, err := dispatcher.Enqueue(ctx, hook, &webhook.WebhookPayload{
+		ActivityType: "memos.memo.created",
+		CreatorID:    user.ID,
+	})
+	require.NoError(t, err)
+	dispatcher.DispatchPending(ctx)
+
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		WebhookID: &hook.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(deliveries))
+	retry, failed := deliveries[0], deliveries[1]
+	require.Equal(t, delivery.ID, failed.ID)
+	require.Equal(t, store.WebhookDeliveryFailed, failed.Status)
+	require.Equal(t, int32(http.StatusInternalServerError), failed.StatusCode)
+	require.Equal(t, store.WebhookDeliveryPending, retry.Status)
+	require.Equal(t, int32(2), retry.Attempt)
+	require.Greater(t, retry.NextAttemptTs, time.Now().Unix())
+
+	responseStatus = http.StatusOK
+	redelivery, err := dispatcher.Redeliver(ctx, failed)
+	require.NoError(t, err)
+	dispatcher.DispatchPending(ctx)
+	redelivery, err = ts.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+		ID: &redelivery.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliverySucceeded, redelivery.Status)
+	require.Equal(t, failed.Payload, redelivery.Payload)
+}
+
+func TestWebhookDispatcherArchivedWebhook(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requested = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	hook, err := ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "test_webhook",
+		Url:       server.URL,
+	})
+	require.NoError(t, err)
+
+	dispatcher := webhookdispatcher.NewWebhookDispatcher(ts)
+	delivery, err := dispatcher.Enqueue(ctx, hook, &webhook.WebhookPayload{
+		ActivityType: "memos.memo.created",
+		CreatorID:    user.ID,
+	})
+	require.NoError(t, err)
+	archived := storepb.RowStatus_ARCHIVED
+	_, err = ts.UpdateWebhook(ctx, &store.UpdateWebhook{
+		ID:        hook.Id,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	dispatcher.DispatchPending(ctx)
+
+	// The delivery is cancelled without a request or a retry.
+	require.False(t, requested)
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		WebhookID: &hook.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(deliveries))
+	require.Equal(t, delivery.ID, deliveries[0].ID)
+	require.Equal(t, store.WebhookDeliveryFailed, deliveries[0].Status)
+	require.Contains(t, deliveries[0].ResponseBody, "archived")
+}
+
+func TestWebhookDispatcherSlowReceiver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	release := make(chan struct{})
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slowServer.Close()
+	defer close(release)
+	fastServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fastServer.Close()
+	slowHook, err := ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "slow_webhook",
+		Url:       slowServer.URL,
+	})
+	require.NoError(t, err)
+	fastHook, err := ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "fast_webhook",
+		Url:       fastServer.URL,
+	})
+	require.NoError(t, err)
+
+	dispatcher := webhookdispatcher.NewWebhookDispatcher(ts)
+	go dispatcher.Run(ctx)
+	payload := &webhook.WebhookPayload{
+		ActivityType: "memos.memo.created",
+		CreatorID:    user.ID,
+	}
+	slowDelivery, err := dispatcher.Enqueue(ctx, slowHook, payload)
+	require.NoError(t, err)
+	fastDelivery, err := dispatcher.Enqueue(ctx, fastHook, payload)
+	require.NoError(t, err)
+
+	// The fast receiver gets its delivery while the slow one is still waiting.
+	require.Eventually(t, func() bool {
+		delivery, err := ts.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+			ID: &fastDelivery.ID,
+		})
+		return err == nil && delivery.Status == store.WebhookDeliverySucceeded
+	}, 5*time.Second, 10*time.Millisecond)
+	delivery, err := ts.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+		ID: &slowDelivery.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliveryPending, delivery.Status)
+}
@@ -48,3 +48,36 @@ func TestWebhookStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(webhooks))
 }
+
+func TestWebhookStoreFilter(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	webhook, err := ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "test_webhook",
+		Url:       "https://example.com",
+		Filter: &storepb.WebhookFilter{
+			EventTypes: []string{"memos.memo.created"},
+		},
+	})
+	require.NoError(t, err)
+	webhooks, err := ts.ListWebhooks(ctx, &store.FindWebhook{
+		ID: &webhook.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(webhooks))
+	require.Equal(t, []string{"memos.memo.created"}, webhooks[0].Filter.EventTypes)
+	updatedWebhook, err := ts.UpdateWebhook(ctx, &store.UpdateWebhook{
+		ID: webhook.Id,
+		Filter: &storepb.WebhookFilter{
+			Visibilities: []string{string(store.Public)},
+			Tags:         []string{"work"},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, updatedWebhook.Filter.EventTypes)
+	require.Equal(t, []string{"PUBLIC"}, updatedWebhook.Filter.Visibilities)
+	require.Equal(t, []string{"work"}, updatedWebhook.Filter.Tags)
+}